@@ -5,6 +5,7 @@ package asposepdf
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
@@ -32,6 +33,22 @@ func assert_ne(t *testing.T, left any, right any) {
 	}
 }
 
+// skip_unreleased skips a test of functions whose native functions the pinned native library does not export.
+func skip_unreleased(t *testing.T) {
+	if !unreleased {
+		t.Skip("requires -tags asposepdf_unreleased")
+	}
+}
+
+// test_certificate returns the PKCS#12 test certificate and its password.
+func test_certificate(t *testing.T) ([]byte, string) {
+	certBytes, err := os.ReadFile("testdata/sign.pfx")
+	if err != nil {
+		t.Fatalf("ReadFile(): %v", err)
+	}
+	return certBytes, "Pa$$w0rd2023"
+}
+
 func TestNewAndSave(t *testing.T) {
 
 	pdf_new_filename := fmt.Sprintf("%s/new.pdf", t.TempDir())
@@ -446,6 +463,77 @@ func TestExtractText(t *testing.T) {
 	}
 }
 
+func TestInspect(t *testing.T) {
+	doc, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	_ = doc.PageAdd()
+	_ = doc.PageAdd()
+	_ = doc.PageSetSize(1, PageSizeA4)
+	_ = doc.PageRotate(2, RotationOn90)
+	if err := doc.PageAddText(2, "Intake triage"); err != nil {
+		t.Fatalf("PageAddText(): %v", err)
+	}
+	filename := fmt.Sprintf("%s/inspect.pdf", t.TempDir())
+	if err := doc.SaveAs(filename); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+	doc.Close()
+
+	pdf, err := Open(filename)
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+	defer pdf.Close()
+	report, err := pdf.Inspect()
+	if err != nil {
+		t.Fatalf("Inspect(): %v", err)
+	}
+
+	// Counts, security and compliance status do not depend on the document properties
+	assert_eq(t, report.PageCount, int32(2))
+	if report.WordCount < 2 || report.CharacterCount == 0 {
+		t.Errorf("Inspect(): %d words, %d characters", report.WordCount, report.CharacterCount)
+	}
+	assert_eq(t, report.Encrypted, false)
+	assert_eq(t, report.Signed, false)
+
+	skip_unreleased(t)
+	info, _ := os.Stat(filename)
+	assert_eq(t, report.FileSize, info.Size())
+	assert_eq(t, len(report.Pages), 2)
+	if report.Version == "" {
+		t.Errorf("Inspect(): empty version")
+	}
+
+	// A4 page without content, rotated page with text
+	assert_eq(t, report.Pages[0], PageInspection{Number: 1, Width: 595, Height: 842, Rotation: 0, Blank: true})
+	assert_eq(t, report.Pages[1].Number, int32(2))
+	assert_eq(t, report.Pages[1].Rotation, int32(90))
+	assert_eq(t, report.Pages[1].Blank, false)
+	if len(report.Fonts) == 0 {
+		t.Errorf("Inspect(): no fonts")
+	}
+
+	assert_eq(t, report.ImageCount, int32(0))
+	assert_eq(t, len(report.Attachments), 0)
+	assert_eq(t, report.HasForms, false)
+	assert_eq(t, report.HasJavaScript, false)
+	assert_eq(t, len(report.Layers), 0)
+
+	// The report is JSON-serializable
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+	var decoded InspectionReport
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	assert_eq(t, decoded.Pages, report.Pages)
+}
+
 func TestConvertFromPDF(t *testing.T) {
 	type conversion struct {
 		name string
@@ -1055,6 +1143,90 @@ func TestPdfaCompliance(t *testing.T) {
 	assert_eq(t, isPdfa, false)
 }
 
+func TestValidationReport(t *testing.T) {
+	log := `<?xml version="1.0" encoding="utf-8"?>
+<Document Name="sample.pdf" Conformance="PDF/A-1B" Operation="Conversion">
+  <Fonts>
+    <Problem Severity="Error" Clause="6.3.4" ObjectID="12 0" Page="2" Convertable="True">Font is not embedded</Problem>
+  </Fonts>
+  <Problem Severity="Warning" Clause="6.7.2.2" Convertable="False">XMP metadata is missing</Problem>
+</Document>`
+	report, err := newValidationReport(PDF_A_1B, false, log)
+	if err != nil {
+		t.Fatalf("newValidationReport(): %v", err)
+	}
+	assert_eq(t, len(report.Issues), 2)
+	assert_eq(t, report.Issues[0], ValidationIssue{Clause: "6.3.4", Severity: "Error", Category: "Fonts", Page: 2, ObjectRef: "12 0", Message: "Font is not embedded", Fixable: true})
+	assert_eq(t, report.Issues[1].Category, "6.7")
+	assert_eq(t, report.Errors, 1)
+	assert_eq(t, report.Warnings, 1)
+	assert_eq(t, report.Fixed, 0)
+	assert_eq(t, report.Categories, map[string]int{"Fonts": 1, "6.7": 1})
+
+	// Nothing is fixed if the validation after the conversion fails
+	remaining, err := newValidationReport(PDF_A_1B, false, `<Document>
+  <Problem Severity="Error" Clause="6.3.4" ObjectID="15 0" Page="2" Convertable="True">Font is not embedded</Problem>
+</Document>`)
+	if err != nil {
+		t.Fatalf("newValidationReport(): %v", err)
+	}
+	report.Valid = true
+	report.markFixed(remaining)
+	assert_eq(t, report.Valid, false)
+	assert_eq(t, report.Fixed, 0)
+	assert_eq(t, len(report.Remaining()), 2)
+
+	// Issues still reported by the passed validation are not fixed, whether fixable or not
+	remaining, err = newValidationReport(PDF_A_1B, true, `<Document>
+  <Problem Severity="Warning" Clause="6.7.2.2" Convertable="False">XMP metadata is missing</Problem>
+</Document>`)
+	if err != nil {
+		t.Fatalf("newValidationReport(): %v", err)
+	}
+	report.markFixed(remaining)
+	assert_eq(t, report.Valid, true)
+	assert_eq(t, report.Issues[0].Fixed, true)
+	assert_eq(t, report.Issues[1].Fixed, false)
+	assert_eq(t, report.Fixed, 1)
+	assert_eq(t, len(report.Remaining()), 1)
+	assert_eq(t, report.Remaining()[0].Clause, "6.7.2.2")
+	if _, err := newValidationReport(PDF_A_1B, false, "<Document>"); err == nil {
+		t.Errorf("newValidationReport(): expected error for a truncated log")
+	}
+
+	pdf, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer pdf.Close()
+	_ = pdf.PageAdd()
+	_ = pdf.PageAddText(1, "Validation report")
+	report, err = pdf.ValidateWithReport(PDF_A_1B)
+	if err != nil {
+		t.Fatalf("ValidateWithReport(): %v", err)
+	}
+	assert_eq(t, report.Format, PDF_A_1B)
+	assert_eq(t, report.Fixed, 0)
+	total := 0
+	for _, count := range report.Categories {
+		total += count
+	}
+	assert_eq(t, total, len(report.Issues))
+
+	report, err = pdf.ConvertWithReport(PDF_A_1B, Delete)
+	if err != nil {
+		t.Fatalf("ConvertWithReport(): %v", err)
+	}
+	assert_eq(t, report.Valid, true)
+	assert_eq(t, report.Fixed+len(report.Remaining()), len(report.Issues))
+	report, err = pdf.ValidateWithReport(PDF_A_1B)
+	if err != nil {
+		t.Fatalf("ValidateWithReport(): %v", err)
+	}
+	assert_eq(t, report.Valid, true)
+	assert_eq(t, report.Errors, 0)
+}
+
 func TestPdfUaCompliance(t *testing.T) {
 	filename := fmt.Sprintf("%s/test.pdf", t.TempDir())
 
@@ -0,0 +1,16 @@
+package asposepdf
+
+import "time"
+
+// AttachmentInfo contains information about a file attached to a PDF-document.
+type AttachmentInfo struct {
+	Name             string         `json:"name"`             // File name of the attachment
+	Description      string         `json:"description"`      // Description of the attachment
+	MimeType         string         `json:"mimetype"`         // MIME type, e.g. text/xml
+	Size             int64          `json:"size"`             // Size of the attached file in bytes
+	CreationDate     time.Time      `json:"creationdate"`     // Creation date, zero if not stored
+	ModificationDate time.Time      `json:"modificationdate"` // Modification date, zero if not stored
+	Checksum         string         `json:"checksum"`         // MD5 checksum of the file as hex, empty if not stored
+	Relationship     AFRelationship `json:"afrelationship"`   // Relationship of the file to the document (PDF/A-3, PDF 2.0)
+	Page             int32          `json:"page"`             // Page of a file attachment annotation, 0 for a document-level attachment
+}
@@ -0,0 +1,128 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path/filepath"
+	"time"
+)
+
+// AttachmentOptions contains settings for AddAttachment and PageAddFileAttachment.
+type AttachmentOptions struct {
+	Name             string         `json:"name"`             // File name of the attachment, required
+	Description      string         `json:"description"`      // Description of the attachment
+	MimeType         string         `json:"mimetype"`         // MIME type, from the extension of Name if empty
+	CreationDate     time.Time      `json:"creationdate"`     // Creation date, the current time if zero
+	ModificationDate time.Time      `json:"modificationdate"` // Modification date, the current time if zero
+	Relationship     AFRelationship `json:"afrelationship"`   // Relationship of the file to the document, e.g. AFAlternative for an e-invoice
+	Icon             string         `json:"icon"`             // Icon of a file attachment annotation: PushPin, Paperclip, Graph or Tag; PushPin if empty
+}
+
+// attachmentIcons are the icon names of file attachment annotations.
+var attachmentIcons = map[string]bool{"": true, "PushPin": true, "Paperclip": true, "Graph": true, "Tag": true}
+
+// marshal validates options and returns them as JSON with defaults applied and the checksum of data.
+// Rect is included for file attachment annotations.
+func (options *AttachmentOptions) marshal(data []byte, rect *Rectangle, now time.Time) (string, error) {
+	if options == nil || options.Name == "" {
+		return "", errors.New("attachment name is empty")
+	}
+	if options.Relationship < AFUnspecified || options.Relationship > AFSchema {
+		return "", fmt.Errorf("invalid AFRelationship %d", options.Relationship)
+	}
+	if !attachmentIcons[options.Icon] {
+		return "", fmt.Errorf("invalid icon %q, expected PushPin, Paperclip, Graph or Tag", options.Icon)
+	}
+	normalized := *options
+	if normalized.MimeType == "" {
+		normalized.MimeType = "application/octet-stream"
+		if mimeType := mime.TypeByExtension(filepath.Ext(options.Name)); mimeType != "" {
+			if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil {
+				normalized.MimeType = mediaType
+			}
+		}
+	}
+	if normalized.CreationDate.IsZero() {
+		normalized.CreationDate = now
+	}
+	if normalized.ModificationDate.IsZero() {
+		normalized.ModificationDate = now
+	}
+	sum := md5.Sum(data)
+	options_json, err := json.Marshal(struct {
+		*AttachmentOptions
+		Checksum string     `json:"checksum"`
+		Rect     *Rectangle `json:"rect,omitempty"`
+	}{&normalized, hex.EncodeToString(sum[:]), rect})
+	if err != nil {
+		return "", err
+	}
+	return string(options_json), nil
+}
+
+// Attachments returns information about files attached to PDF-document,
+// including files of file attachment annotations on pages.
+//
+// Example:
+//
+//	attachments, err := pdf.Attachments()
+func (document *Document) Attachments() ([]AttachmentInfo, error) {
+	return document.attachments()
+}
+
+// ExtractAttachment writes the contents of the attached file with name to w.
+//
+// Example:
+//
+//	file, _ := os.Create("invoice.xml")
+//	defer file.Close()
+//	err := pdf.ExtractAttachment("invoice.xml", file)
+func (document *Document) ExtractAttachment(name string, w io.Writer) error {
+	return document.extractAttachment(name, w)
+}
+
+// AddAttachment attaches a file read from r to PDF-document.
+// The MIME type is detected from the extension of options.Name if empty.
+//
+// Example:
+//
+//	file, _ := os.Open("data.csv")
+//	defer file.Close()
+//	err := pdf.AddAttachment(file, &asposepdf.AttachmentOptions{Name: "data.csv", Description: "Source data", Relationship: asposepdf.AFData})
+func (document *Document) AddAttachment(r io.Reader, options *AttachmentOptions) error {
+	data, e := io.ReadAll(r)
+	if e != nil {
+		return fmt.Errorf("AddAttachment(): %w", e)
+	}
+	options_json, e := options.marshal(data, nil, time.Now())
+	if e != nil {
+		return fmt.Errorf("AddAttachment(): %w", e)
+	}
+	return document.addAttachment(data, options_json)
+}
+
+// PageAddFileAttachment adds a file attachment annotation with a file read from r to page.
+//
+// Example:
+//
+//	file, _ := os.Open("notes.txt")
+//	defer file.Close()
+//	err := pdf.PageAddFileAttachment(1, asposepdf.Rectangle{X: 500, Y: 750, Width: 20, Height: 20}, file, &asposepdf.AttachmentOptions{Name: "notes.txt", Icon: "Paperclip"})
+func (document *Document) PageAddFileAttachment(num int32, rect Rectangle, r io.Reader, options *AttachmentOptions) error {
+	data, e := io.ReadAll(r)
+	if e != nil {
+		return fmt.Errorf("PageAddFileAttachment(): %w", e)
+	}
+	options_json, e := options.marshal(data, &rect, time.Now())
+	if e != nil {
+		return fmt.Errorf("PageAddFileAttachment(): %w", e)
+	}
+	return document.pageAddFileAttachment(num, data, options_json)
+}
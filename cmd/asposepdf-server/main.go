@@ -0,0 +1,66 @@
+// Command asposepdf-server serves document operations over HTTP.
+//
+// Usage:
+//
+//	asposepdf-server [-addr :8080] [-workers N] [-max-request-size MiB] [-timeout 2m] [-temp-dir dir]
+//
+// See package github.com/aspose-pdf/aspose-pdf-go-cpp/server for the endpoints.
+// The server stops gracefully on SIGINT or SIGTERM.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp/server"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "listen address")
+	workers := flag.Int("workers", 0, "number of requests processed concurrently, number of CPUs if 0")
+	maxRequestSize := flag.Int64("max-request-size", 64, "maximum request body size in MiB")
+	timeout := flag.Duration("timeout", 2*time.Minute, "maximum time to wait for a worker and process a request")
+	tempDir := flag.String("temp-dir", "", "directory for uploaded and generated files, system temporary directory if empty")
+	flag.Parse()
+
+	srv := server.New(server.Config{
+		Workers:        *workers,
+		MaxRequestSize: *maxRequestSize << 20,
+		Timeout:        *timeout,
+		TempDir:        *tempDir,
+	})
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           srv,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       *timeout,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	shutdown := make(chan struct{})
+	go func() {
+		defer close(shutdown)
+		<-ctx.Done()
+		log.Print("shutting down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), *timeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("shutdown: %v", err)
+		}
+	}()
+
+	log.Printf("listening on %s", *addr)
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+	<-shutdown
+	srv.Close()
+}
@@ -0,0 +1,720 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+func runMerge(e *env, args []string) error {
+	fs := newFlagSet(e, "merge", "<input>...")
+	output := fs.String("o", "", "output file, - for standard output")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		return usagef("output is required (-o)")
+	}
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	documents := make([]*asposepdf.Document, 0, len(inputs))
+	defer func() {
+		for _, pdf := range documents {
+			pdf.Close()
+		}
+	}()
+	for _, in := range inputs {
+		pdf, err := openInput(in, *password)
+		if err != nil {
+			return err
+		}
+		documents = append(documents, pdf)
+	}
+	merged, err := asposepdf.MergeDocuments(documents)
+	if err != nil {
+		return err
+	}
+	defer merged.Close()
+	return writeOutput(e, *output, merged.SaveAs)
+}
+
+func runSplit(e *env, args []string) error {
+	fs := newFlagSet(e, "split", "<input>")
+	pages := fs.String("pages", "", `page ranges of the parts separated by ";", e.g. "1-2;3;4-"`)
+	output := fs.String("o", "", `output file name pattern with %d for the part number, e.g. "part-%d.pdf"`)
+	password := fs.String("password", "", "password of the input")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *pages == "" {
+		return usagef("page ranges are required (-pages)")
+	}
+	if !strings.Contains(*output, "%d") {
+		return usagef("output pattern with %%d is required (-o)")
+	}
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	if len(inputs) != 1 {
+		return usagef("split accepts exactly one input")
+	}
+	pdf, err := openInput(inputs[0], *password)
+	if err != nil {
+		return err
+	}
+	defer pdf.Close()
+	parts, err := pdf.Split(*pages)
+	if err != nil {
+		return err
+	}
+	for i, part := range parts {
+		err := part.SaveAs(numberedOutput(*output, i+1))
+		part.Close()
+		if err != nil {
+			return fmt.Errorf("part %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func runRotate(e *env, args []string) error {
+	fs := newFlagSet(e, "rotate", "<input>...")
+	angle := fs.Int("angle", 90, "clockwise rotation: 90, 180 or 270")
+	page := fs.Int("page", 0, "page number to rotate, all pages if 0")
+	output := fs.String("o", "", "output file, directory for several inputs, - for standard output")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	rotation, err := parseRotation(*angle)
+	if err != nil {
+		return err
+	}
+	return forEach(e, patterns, *password, *output, func(pdf *asposepdf.Document) error {
+		if *page > 0 {
+			return pdf.PageRotate(int32(*page), rotation)
+		}
+		return pdf.Rotate(rotation)
+	})
+}
+
+func runEncrypt(e *env, args []string) error {
+	fs := newFlagSet(e, "encrypt", "<input>...")
+	user := fs.String("user", "", "user password")
+	owner := fs.String("owner", "", "owner password")
+	algorithm := fs.String("algorithm", "aes-256", "crypto algorithm: rc4-40, rc4-128, aes-128 or aes-256")
+	perms := fs.String("permissions", "print", "comma-separated permissions: "+strings.Join(permissionNames(), ", "))
+	pdf20 := fs.Bool("pdf20", false, "use PDF 2.0 encryption")
+	output := fs.String("o", "", "output file, directory for several inputs, - for standard output")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *user == "" && *owner == "" {
+		return usagef("user or owner password is required (-user, -owner)")
+	}
+	cryptoAlgorithm, err := parseCryptoAlgorithm(*algorithm)
+	if err != nil {
+		return err
+	}
+	permissions, err := parsePermissions(*perms)
+	if err != nil {
+		return err
+	}
+	return forEach(e, patterns, "", *output, func(pdf *asposepdf.Document) error {
+		return pdf.Encrypt(*user, *owner, permissions, cryptoAlgorithm, *pdf20)
+	})
+}
+
+func runDecrypt(e *env, args []string) error {
+	fs := newFlagSet(e, "decrypt", "<input>...")
+	password := fs.String("password", "", "user or owner password")
+	output := fs.String("o", "", "output file, directory for several inputs, - for standard output")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *password == "" {
+		return usagef("password is required (-password)")
+	}
+	return forEach(e, patterns, *password, *output, (*asposepdf.Document).Decrypt)
+}
+
+// saveFormat is a conversion format with its file extension and save function.
+type saveFormat struct {
+	ext  string
+	save func(pdf *asposepdf.Document, filename string) error
+}
+
+// saveFormats maps conversion format names to their formats.
+var saveFormats = map[string]saveFormat{
+	"docx":          {".docx", (*asposepdf.Document).SaveDocX},
+	"docx-enhanced": {".docx", (*asposepdf.Document).SaveDocXEnhanced},
+	"doc":           {".doc", (*asposepdf.Document).SaveDoc},
+	"xlsx":          {".xlsx", (*asposepdf.Document).SaveXlsX},
+	"pptx":          {".pptx", (*asposepdf.Document).SavePptX},
+	"xps":           {".xps", (*asposepdf.Document).SaveXps},
+	"txt":           {".txt", (*asposepdf.Document).SaveTxt},
+	"epub":          {".epub", (*asposepdf.Document).SaveEpub},
+	"tex":           {".tex", (*asposepdf.Document).SaveTeX},
+	"md":            {".md", (*asposepdf.Document).SaveMarkdown},
+	"svgzip":        {".zip", (*asposepdf.Document).SaveSvgZip},
+	"booklet":       {".pdf", (*asposepdf.Document).SaveBooklet},
+	"tiff": {".tiff", func(pdf *asposepdf.Document, filename string) error {
+		return pdf.SaveTiff(filename)
+	}},
+}
+
+// formatFromExt returns the conversion format of a file extension.
+func formatFromExt(filename string) string {
+	ext := strings.ToLower(filepath.Ext(filename))
+	switch ext {
+	case ".tif":
+		return "tiff"
+	case ".htm":
+		return "html"
+	case ".zip":
+		return "svgzip"
+	case ".pdf", "":
+		return ""
+	}
+	return strings.TrimPrefix(ext, ".")
+}
+
+func runConvert(e *env, args []string) error {
+	fs := newFlagSet(e, "convert", "<input>...")
+	to := fs.String("to", "", "target format: "+strings.Join(sortedKeys(saveFormats), ", ")+" or a PDF standard like PDF_A_2B; from the output extension if empty")
+	output := fs.String("o", "", "output file, directory for several inputs, - for standard output")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	format := *to
+	if format == "" {
+		format = formatFromExt(*output)
+	}
+	if format == "" {
+		return usagef("target format is required (-to)")
+	}
+
+	if pdfFormat, err := parsePdfFormat(format); err == nil {
+		return forEach(e, patterns, *password, *output, func(pdf *asposepdf.Document) error {
+			ok, log, err := pdf.Convert(pdfFormat, asposepdf.Delete)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("conversion to %s failed: %s", format, log)
+			}
+			return nil
+		})
+	}
+
+	target, ok := saveFormats[strings.ToLower(format)]
+	if !ok {
+		return usagef("unknown target format %q", format)
+	}
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	for _, in := range inputs {
+		filename, err := outputFor(in, *output, len(inputs) > 1, target.ext)
+		if err != nil {
+			return err
+		}
+		pdf, err := openInput(in, *password)
+		if err != nil {
+			return err
+		}
+		err = writeOutput(e, filename, func(filename string) error { return target.save(pdf, filename) })
+		pdf.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", in.name, err)
+		}
+	}
+	return nil
+}
+
+// validationReport is the JSON output of validate.
+type validationReport struct {
+	File       string                      `json:"file"`
+	Format     string                      `json:"format"`
+	Valid      bool                        `json:"valid"`
+	Issues     []asposepdf.ValidationIssue `json:"issues,omitempty"`
+	Categories map[string]int              `json:"categories,omitempty"`
+	Log        string                      `json:"log,omitempty"`
+	Error      string                      `json:"error,omitempty"`
+}
+
+func runValidate(e *env, args []string) error {
+	fs := newFlagSet(e, "validate", "<input>...")
+	format := fs.String("format", "", "PDF standard, e.g. PDF_A_1B, PDF_A_2B or PDF_UA_1")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	pdfFormat, err := parsePdfFormat(*format)
+	if err != nil {
+		return err
+	}
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(e.stdout)
+	var result error
+	for _, in := range inputs {
+		report := validationReport{File: in.name, Format: strings.ToUpper(*format)}
+		pdf, err := openInput(in, *password)
+		if err == nil {
+			var validation *asposepdf.ValidationReport
+			validation, err = pdf.ValidateWithReport(pdfFormat)
+			pdf.Close()
+			if err == nil {
+				report.Valid, report.Issues, report.Categories, report.Log = validation.Valid, validation.Issues, validation.Categories, validation.Log
+			}
+		}
+		if err != nil {
+			report.Error = err.Error()
+			result = err
+		} else if !report.Valid && result == nil {
+			result = errNonCompliant
+		}
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	}
+	return result
+}
+
+func runOptimize(e *env, args []string) error {
+	fs := newFlagSet(e, "optimize", "<input>...")
+	resources := fs.Bool("resources", false, "remove unused and duplicate resources")
+	imageQuality := fs.Int("image-quality", 0, "compress images with quality 1-100, no compression if 0")
+	output := fs.String("o", "", "output file, directory for several inputs, - for standard output")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *imageQuality < 0 || *imageQuality > 100 {
+		return usagef("image quality must be from 1 to 100")
+	}
+	return forEach(e, patterns, *password, *output, func(pdf *asposepdf.Document) error {
+		if err := pdf.Optimize(); err != nil {
+			return err
+		}
+		if *resources {
+			if err := pdf.OptimizeResource(); err != nil {
+				return err
+			}
+		}
+		if *imageQuality > 0 {
+			return pdf.OptimizeFileSize(int32(*imageQuality))
+		}
+		return nil
+	})
+}
+
+func runWatermark(e *env, args []string) error {
+	fs := newFlagSet(e, "watermark", "<input>...")
+	text := fs.String("text", "", "watermark text")
+	fontName := fs.String("font", "Arial", "font name of the text")
+	fontSize := fs.Float64("size", 48, "font size of the text")
+	color := fs.String("color", "#808080", "text color as #RRGGBB")
+	x := fs.Int("x", 100, "horizontal position from the left edge of the page")
+	y := fs.Int("y", 100, "vertical position from the bottom edge of the page")
+	rotation := fs.Int("rotation", 0, "rotation in degrees counterclockwise")
+	opacity := fs.Float64("opacity", 0.5, "opacity from 0 to 1")
+	background := fs.Bool("background", false, "place the watermark behind the content")
+	output := fs.String("o", "", "output file, directory for several inputs, - for standard output")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *text == "" {
+		return usagef("text is required (-text)")
+	}
+	if *opacity < 0 || *opacity > 1 {
+		return usagef("opacity must be from 0 to 1")
+	}
+	return forEach(e, patterns, *password, *output, func(pdf *asposepdf.Document) error {
+		return pdf.AddWatermark(*text, *fontName, *fontSize, *color, int32(*x), int32(*y), int32(*rotation), *background, *opacity)
+	})
+}
+
+func runSign(e *env, args []string) error {
+	fs := newFlagSet(e, "sign", "<input>...")
+	certFile := fs.String("cert", "", "PKCS#12 (.pfx) file with the certificate and private key")
+	certPassword := fs.String("cert-password", "", "password of the PKCS#12 file")
+	reason := fs.String("reason", "", "reason of signing")
+	location := fs.String("location", "", "location of signing")
+	contact := fs.String("contact", "", "contact information of the signer")
+	page := fs.Int("page", 1, "page number of the signature")
+	rect := fs.String("rect", "", `position of a visible signature as "x,y,width,height"`)
+	image := fs.String("image", "", "PNG or JPEG file of the visible signature appearance")
+	output := fs.String("o", "", "output file, directory for several inputs, - for standard output")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *certFile == "" {
+		return usagef("certificate is required (-cert)")
+	}
+	signData, err := os.ReadFile(*certFile)
+	if err != nil {
+		return err
+	}
+	var x, y, width, height int32
+	visible := *rect != ""
+	if visible {
+		if x, y, width, height, err = parseRect(*rect); err != nil {
+			return err
+		}
+	}
+	var appearance []byte
+	if *image != "" {
+		if !visible {
+			return usagef("image requires a visible signature (-rect)")
+		}
+		if appearance, err = os.ReadFile(*image); err != nil {
+			return err
+		}
+	}
+
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	for _, in := range inputs {
+		filename, err := outputFor(in, *output, len(inputs) > 1, ".pdf")
+		if err != nil {
+			return err
+		}
+		pdf, err := openInput(in, *password)
+		if err != nil {
+			return err
+		}
+		err = writeOutput(e, filename, func(filename string) error {
+			return pdf.SignPKCS7(int32(*page), signData, *certPassword, x, y, height, width, *reason, *contact, *location, visible, appearance, filename)
+		})
+		pdf.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", in.name, err)
+		}
+	}
+	return nil
+}
+
+// documentInfo is the JSON output of info.
+type documentInfo struct {
+	File        string   `json:"file"`
+	Pages       int32    `json:"pages"`
+	Encrypted   bool     `json:"encrypted"`
+	Permissions []string `json:"permissions"`
+	Signed      bool     `json:"signed"`
+	PdfA        bool     `json:"pdfa"`
+	PdfUA       bool     `json:"pdfua"`
+	WordCount   int32    `json:"wordcount"`
+	unreleasedInfo
+}
+
+func runInfo(e *env, args []string) error {
+	fs := newFlagSet(e, "info", "<input>...")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(e.stdout)
+	for _, in := range inputs {
+		info, err := inspect(in, *password)
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inspect collects information about the input document.
+func inspect(in input, password string) (*documentInfo, error) {
+	pdf, err := openInput(in, password)
+	if err != nil {
+		return nil, err
+	}
+	defer pdf.Close()
+
+	info := &documentInfo{File: in.name}
+	wrap := func(err error) error { return fmt.Errorf("%s: %w", in.name, err) }
+	if info.Pages, err = pdf.PageCount(); err != nil {
+		return nil, wrap(err)
+	}
+	if info.Encrypted, err = pdf.IsEncrypted(); err != nil {
+		return nil, wrap(err)
+	}
+	granted, err := pdf.GetPermissions()
+	if err != nil {
+		return nil, wrap(err)
+	}
+	info.Permissions = make([]string, 0, len(permissions))
+	for _, name := range permissionNames() {
+		if granted&permissions[name] != 0 {
+			info.Permissions = append(info.Permissions, name)
+		}
+	}
+	if info.Signed, err = pdf.IsSigned(); err != nil {
+		return nil, wrap(err)
+	}
+	if info.PdfA, err = pdf.IsPdfaCompliant(); err != nil {
+		return nil, wrap(err)
+	}
+	if info.PdfUA, err = pdf.IsPdfUaCompliant(); err != nil {
+		return nil, wrap(err)
+	}
+	if info.WordCount, err = pdf.WordCount(); err != nil {
+		return nil, wrap(err)
+	}
+	if err := info.unreleasedInfo.collect(pdf); err != nil {
+		return nil, wrap(err)
+	}
+	return info, nil
+}
+
+func runText(e *env, args []string) error {
+	fs := newFlagSet(e, "text", "<input>...")
+	output := fs.String("o", "-", "output text file, - for standard output")
+	password := fs.String("password", "", "password of the inputs")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	w := e.stdout
+	if *output != stdio {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	for _, in := range inputs {
+		pdf, err := openInput(in, *password)
+		if err != nil {
+			return err
+		}
+		text, err := pdf.ExtractText()
+		pdf.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", in.name, err)
+		}
+		if _, err := fmt.Fprintln(w, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderFormats maps image format names to their page rendering functions.
+var renderFormats = map[string]func(pdf *asposepdf.Document, num int32, dpi int32, filename string) error{
+	"png":   (*asposepdf.Document).PageToPng,
+	"jpg":   (*asposepdf.Document).PageToJpg,
+	"bmp":   (*asposepdf.Document).PageToBmp,
+	"tiff":  (*asposepdf.Document).PageToTiff,
+	"dicom": (*asposepdf.Document).PageToDICOM,
+	"svg": func(pdf *asposepdf.Document, num int32, dpi int32, filename string) error {
+		return pdf.PageToSvg(num, filename)
+	},
+}
+
+func runRender(e *env, args []string) error {
+	fs := newFlagSet(e, "render", "<input>")
+	format := fs.String("format", "png", "image format: png, jpg, bmp, tiff, dicom or svg")
+	dpi := fs.Int("dpi", 150, "resolution in dots per inch")
+	page := fs.Int("page", 0, "page number to render, all pages if 0")
+	output := fs.String("o", "", `output file; pattern with %d for the page number when rendering all pages, e.g. "page-%d.png"`)
+	password := fs.String("password", "", "password of the input")
+	patterns, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	render, ok := renderFormats[strings.ToLower(*format)]
+	if !ok {
+		return usagef("unknown image format %q", *format)
+	}
+	if *output == "" {
+		return usagef("output is required (-o)")
+	}
+	if *page == 0 && !strings.Contains(*output, "%d") {
+		return usagef("output pattern with %%d is required to render all pages")
+	}
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	if len(inputs) != 1 {
+		return usagef("render accepts exactly one input")
+	}
+	pdf, err := openInput(inputs[0], *password)
+	if err != nil {
+		return err
+	}
+	defer pdf.Close()
+
+	first, last := int32(*page), int32(*page)
+	if *page == 0 {
+		count, err := pdf.PageCount()
+		if err != nil {
+			return err
+		}
+		first, last = 1, count
+	}
+	for num := first; num <= last; num++ {
+		filename := *output
+		if strings.Contains(filename, "%d") {
+			filename = numberedOutput(filename, int(num))
+		}
+		err := writeOutput(e, filename, func(filename string) error { return render(pdf, num, int32(*dpi), filename) })
+		if err != nil {
+			return fmt.Errorf("page %d: %w", num, err)
+		}
+	}
+	return nil
+}
+
+func parseRotation(angle int) (int32, error) {
+	switch angle {
+	case 90:
+		return asposepdf.RotationOn90, nil
+	case 180:
+		return asposepdf.RotationOn180, nil
+	case 270:
+		return asposepdf.RotationOn270, nil
+	}
+	return 0, usagef("rotation must be 90, 180 or 270, got %d", angle)
+}
+
+var cryptoAlgorithms = map[string]asposepdf.CryptoAlgorithm{
+	"rc4-40":  asposepdf.RC4x40,
+	"rc4-128": asposepdf.RC4x128,
+	"aes-128": asposepdf.AESx128,
+	"aes-256": asposepdf.AESx256,
+}
+
+func parseCryptoAlgorithm(name string) (asposepdf.CryptoAlgorithm, error) {
+	algorithm, ok := cryptoAlgorithms[strings.ToLower(name)]
+	if !ok {
+		return 0, usagef("unknown crypto algorithm %q", name)
+	}
+	return algorithm, nil
+}
+
+var permissions = map[string]asposepdf.Permissions{
+	"print":         asposepdf.PrintDocument,
+	"modify":        asposepdf.ModifyContent,
+	"extract":       asposepdf.ExtractContent,
+	"annotate":      asposepdf.ModifyTextAnnotations,
+	"fill-form":     asposepdf.FillForm,
+	"accessibility": asposepdf.ExtractContentWithDisabilities,
+	"assemble":      asposepdf.AssembleDocument,
+	"print-hq":      asposepdf.PrintingQuality,
+}
+
+func permissionNames() []string {
+	return sortedKeys(permissions)
+}
+
+func parsePermissions(list string) (asposepdf.Permissions, error) {
+	var result asposepdf.Permissions
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(strings.ToLower(name))
+		if name == "" {
+			continue
+		}
+		permission, ok := permissions[name]
+		if !ok {
+			return 0, usagef("unknown permission %q", name)
+		}
+		result |= permission
+	}
+	return result, nil
+}
+
+var pdfFormats = map[string]asposepdf.PdfFormat{
+	"PDF_A_1A": asposepdf.PDF_A_1A, "PDF_A_1B": asposepdf.PDF_A_1B,
+	"PDF_A_2A": asposepdf.PDF_A_2A, "PDF_A_2B": asposepdf.PDF_A_2B, "PDF_A_2U": asposepdf.PDF_A_2U,
+	"PDF_A_3A": asposepdf.PDF_A_3A, "PDF_A_3B": asposepdf.PDF_A_3B, "PDF_A_3U": asposepdf.PDF_A_3U,
+	"PDF_A_4": asposepdf.PDF_A_4, "PDF_A_4E": asposepdf.PDF_A_4E, "PDF_A_4F": asposepdf.PDF_A_4F,
+	"PDF_UA_1":      asposepdf.PDF_UA_1,
+	"PDF_X_1A_2001": asposepdf.PDF_X_1A_2001, "PDF_X_1A": asposepdf.PDF_X_1A, "PDF_X_3": asposepdf.PDF_X_3, "PDF_X_4": asposepdf.PDF_X_4,
+	"PDF_E_1": asposepdf.PDF_E_1,
+	"ZUGFERD": asposepdf.ZUGFeRD,
+	"V_1_0":   asposepdf.V_1_0, "V_1_1": asposepdf.V_1_1, "V_1_2": asposepdf.V_1_2, "V_1_3": asposepdf.V_1_3,
+	"V_1_4": asposepdf.V_1_4, "V_1_5": asposepdf.V_1_5, "V_1_6": asposepdf.V_1_6, "V_1_7": asposepdf.V_1_7,
+	"V_2_0": asposepdf.V_2_0,
+}
+
+// parsePdfFormat accepts names of PdfFormat constants case-insensitively, with "-" or "/" instead of "_".
+func parsePdfFormat(name string) (asposepdf.PdfFormat, error) {
+	if name == "" {
+		return 0, usagef("PDF format is required")
+	}
+	normalized := strings.NewReplacer("-", "_", "/", "_").Replace(strings.ToUpper(name))
+	format, ok := pdfFormats[normalized]
+	if !ok {
+		return 0, usagef("unknown PDF format %q", name)
+	}
+	return format, nil
+}
+
+// parseRect parses "x,y,width,height".
+func parseRect(s string) (x, y, width, height int32, err error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return 0, 0, 0, 0, usagef("rectangle must be x,y,width,height, got %q", s)
+	}
+	values := make([]int32, 4)
+	for i, part := range parts {
+		value, err := strconv.ParseInt(strings.TrimSpace(part), 10, 32)
+		if err != nil {
+			return 0, 0, 0, 0, usagef("rectangle must be x,y,width,height, got %q", s)
+		}
+		values[i] = int32(value)
+	}
+	return values[0], values[1], values[2], values[3], nil
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+// stdio is the file name of standard input and output.
+const stdio = "-"
+
+// input is an input file resolved from the command line.
+type input struct {
+	name string // Name shown in messages and reports
+	path string // Path of the file to open
+}
+
+// base returns the input name without directory and extension.
+func (in input) base() string {
+	if in.path != in.name {
+		return "stdin"
+	}
+	return strings.TrimSuffix(filepath.Base(in.name), filepath.Ext(in.name))
+}
+
+// resolveInputs expands glob patterns and copies standard input to a temporary file.
+// The returned cleanup function removes temporary files.
+func resolveInputs(e *env, patterns []string) ([]input, func(), error) {
+	var inputs []input
+	var temps []string
+	cleanup := func() {
+		for _, temp := range temps {
+			os.Remove(temp)
+		}
+	}
+	if len(patterns) == 0 {
+		return nil, cleanup, usagef("no input files")
+	}
+	for _, pattern := range patterns {
+		switch {
+		case pattern == stdio:
+			if len(temps) > 0 {
+				cleanup()
+				return nil, func() {}, usagef("standard input can be used only once")
+			}
+			temp, err := copyToTemp(e.stdin, ".pdf")
+			if err != nil {
+				cleanup()
+				return nil, func() {}, fmt.Errorf("failed to read standard input: %w", err)
+			}
+			temps = append(temps, temp)
+			inputs = append(inputs, input{name: "<stdin>", path: temp})
+		case strings.ContainsAny(pattern, "*?["):
+			matches, err := filepath.Glob(pattern)
+			if err != nil {
+				cleanup()
+				return nil, func() {}, usagef("invalid pattern %q: %v", pattern, err)
+			}
+			if len(matches) == 0 {
+				cleanup()
+				return nil, func() {}, fmt.Errorf("no files match %q", pattern)
+			}
+			for _, match := range matches {
+				inputs = append(inputs, input{name: match, path: match})
+			}
+		default:
+			inputs = append(inputs, input{name: pattern, path: pattern})
+		}
+	}
+	return inputs, cleanup, nil
+}
+
+// copyToTemp copies r to a new temporary file with extension ext and returns its name.
+func copyToTemp(r io.Reader, ext string) (string, error) {
+	file, err := os.CreateTemp("", "asposepdf-*"+ext)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if _, err := io.Copy(file, r); err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// openInput opens in, with password if it is not empty.
+func openInput(in input, password string) (*asposepdf.Document, error) {
+	var pdf *asposepdf.Document
+	var err error
+	if password != "" {
+		pdf, err = asposepdf.OpenWithPassword(in.path, password)
+	} else {
+		pdf, err = asposepdf.Open(in.path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", in.name, err)
+	}
+	return pdf, nil
+}
+
+// outputFor returns the output file name for in.
+//
+// With several inputs output must be a directory, and the file is named after the input with extension ext.
+func outputFor(in input, output string, multi bool, ext string) (string, error) {
+	if output == "" {
+		return "", usagef("output is required (-o)")
+	}
+	if !multi {
+		return output, nil
+	}
+	if output == stdio {
+		return "", usagef("output cannot be standard output for several inputs")
+	}
+	info, err := os.Stat(output)
+	if err != nil || !info.IsDir() {
+		return "", usagef("output must be an existing directory for several inputs")
+	}
+	return filepath.Join(output, in.base()+ext), nil
+}
+
+// numberedOutput replaces the first %d of pattern with n; other % characters are kept as is.
+func numberedOutput(pattern string, n int) string {
+	return strings.Replace(pattern, "%d", strconv.Itoa(n), 1)
+}
+
+// writeOutput calls save with filename, or with a temporary file copied to standard output if filename is "-".
+func writeOutput(e *env, filename string, save func(filename string) error) error {
+	if filename != stdio {
+		return save(filename)
+	}
+	temp, err := os.CreateTemp("", "asposepdf-out-*")
+	if err != nil {
+		return err
+	}
+	temp.Close()
+	defer os.Remove(temp.Name())
+	if err := save(temp.Name()); err != nil {
+		return err
+	}
+	file, err := os.Open(temp.Name())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(e.stdout, file)
+	return err
+}
+
+// forEach opens every input, applies process and saves the result as PDF-document.
+func forEach(e *env, patterns []string, password, output string, process func(pdf *asposepdf.Document) error) error {
+	inputs, cleanup, err := resolveInputs(e, patterns)
+	defer cleanup()
+	if err != nil {
+		return err
+	}
+	for _, in := range inputs {
+		filename, err := outputFor(in, output, len(inputs) > 1, ".pdf")
+		if err != nil {
+			return err
+		}
+		if err := processFile(e, in, password, filename, process); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processFile opens in, applies process and saves the result with filename.
+func processFile(e *env, in input, password, filename string, process func(pdf *asposepdf.Document) error) error {
+	pdf, err := openInput(in, password)
+	if err != nil {
+		return err
+	}
+	defer pdf.Close()
+	if err := process(pdf); err != nil {
+		return fmt.Errorf("%s: %w", in.name, err)
+	}
+	if err := writeOutput(e, filename, pdf.SaveAs); err != nil {
+		return fmt.Errorf("%s: %w", in.name, err)
+	}
+	return nil
+}
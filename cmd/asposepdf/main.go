@@ -0,0 +1,163 @@
+// Command asposepdf processes PDF-documents from the command line.
+//
+// Usage:
+//
+//	asposepdf <command> [flags] <input>...
+//
+// Inputs may be file names, glob patterns or "-" for standard input.
+// Output "-" writes the result to standard output.
+// Inspection commands (info, validate) print one JSON object per input.
+//
+// Exit codes: 0 on success, 1 if an operation failed, 2 on invalid usage,
+// 3 if validate found a non-compliant document.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// Exit codes.
+const (
+	exitOK           = 0
+	exitFailure      = 1
+	exitUsage        = 2
+	exitNonCompliant = 3
+)
+
+// errNonCompliant is returned by validate if a document is not compliant.
+var errNonCompliant = errors.New("document is not compliant")
+
+// usageError is an error caused by invalid command-line arguments.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string { return e.msg }
+
+func usagef(format string, args ...any) error {
+	return &usageError{fmt.Sprintf(format, args...)}
+}
+
+// env holds the standard streams of a command.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// command is a subcommand of the tool.
+type command struct {
+	summary string
+	run     func(e *env, args []string) error
+}
+
+var commands = map[string]command{
+	"merge":     {"merge inputs into one PDF-document", runMerge},
+	"split":     {"split a PDF-document by page ranges", runSplit},
+	"rotate":    {"rotate pages", runRotate},
+	"encrypt":   {"encrypt with passwords", runEncrypt},
+	"decrypt":   {"remove encryption", runDecrypt},
+	"convert":   {"convert to Office, text, image formats or PDF standards", runConvert},
+	"validate":  {"validate compliance with a PDF standard", runValidate},
+	"optimize":  {"optimize content and file size", runOptimize},
+	"watermark": {"add a text watermark", runWatermark},
+	"sign":      {"sign with a PKCS#12 certificate", runSign},
+	"info":      {"print document information as JSON", runInfo},
+	"text":      {"extract plain text", runText},
+	"render":    {"render pages to images", runRender},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], &env{os.Stdin, os.Stdout, os.Stderr}))
+}
+
+// run executes the command line and returns the exit code.
+func run(args []string, e *env) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		usage(e.stderr)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return exitOK
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(e.stderr, "asposepdf: unknown command %q\n", args[0])
+		usage(e.stderr)
+		return exitUsage
+	}
+	err := cmd.run(e, args[1:])
+	var usageErr *usageError
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(e.stderr, "asposepdf %s: %v\n", args[0], err)
+		return exitUsage
+	case errors.Is(err, errNonCompliant):
+		return exitNonCompliant
+	default:
+		fmt.Fprintf(e.stderr, "asposepdf %s: %v\n", args[0], err)
+		return exitFailure
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: asposepdf <command> [flags] <input>...")
+	fmt.Fprintln(w, "\nCommands:")
+	for _, name := range sortedKeys(commands) {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(w, "\nRun 'asposepdf <command> -h' for flags of a command.")
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// newFlagSet returns a flag set of the command writing its usage to stderr.
+func newFlagSet(e *env, name string, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(e.stderr, "Usage: asposepdf %s [flags] %s\n\nFlags:\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses flags placed before, between or after positional arguments
+// and returns the positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, &usageError{err.Error()}
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		if args[0] == "--" {
+			return append(positional, args[1:]...), nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+func testEnv() (*env, *bytes.Buffer, *bytes.Buffer) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	return &env{strings.NewReader(""), stdout, stderr}, stdout, stderr
+}
+
+func TestRunExitCodes(t *testing.T) {
+	tests := []struct {
+		args []string
+		code int
+	}{
+		{nil, exitUsage},
+		{[]string{"help"}, exitOK},
+		{[]string{"unknown"}, exitUsage},
+		{[]string{"rotate", "-h"}, exitOK},
+		{[]string{"rotate", "-angle", "45", "in.pdf"}, exitUsage},
+		{[]string{"merge", "in.pdf"}, exitUsage},
+		{[]string{"split", "-pages", "1", "-o", "out.pdf", "in.pdf"}, exitUsage},
+		{[]string{"info"}, exitUsage},
+		{[]string{"info", "no-such-dir/*.pdf"}, exitFailure},
+	}
+	for _, test := range tests {
+		e, _, stderr := testEnv()
+		if code := run(test.args, e); code != test.code {
+			t.Errorf("run(%q) = %d, want %d; stderr: %s", test.args, code, test.code, stderr)
+		}
+	}
+}
+
+// testPdf saves a new PDF-document with the given number of empty pages in dir.
+func testPdf(t *testing.T, dir, name string, pages int) string {
+	t.Helper()
+	pdf, err := asposepdf.New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer pdf.Close()
+	for i := 0; i < pages; i++ {
+		if err := pdf.PageAdd(); err != nil {
+			t.Fatalf("PageAdd(): %v", err)
+		}
+	}
+	filename := filepath.Join(dir, name)
+	if err := pdf.SaveAs(filename); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+	return filename
+}
+
+func TestMergeAndInfo(t *testing.T) {
+	dir := t.TempDir()
+	first := testPdf(t, dir, "first.pdf", 1)
+	second := testPdf(t, dir, "second.pdf", 2)
+	merged := filepath.Join(dir, "merged.pdf")
+
+	e, _, stderr := testEnv()
+	if code := run([]string{"merge", "-o", merged, first, second}, e); code != exitOK {
+		t.Fatalf("merge = %d, want %d; stderr: %s", code, exitOK, stderr)
+	}
+	e, stdout, stderr := testEnv()
+	if code := run([]string{"rotate", "-angle", "90", "-o", "-", merged}, e); code != exitOK {
+		t.Fatalf("rotate = %d, want %d; stderr: %s", code, exitOK, stderr)
+	}
+	if !bytes.HasPrefix(stdout.Bytes(), []byte("%PDF-")) {
+		t.Errorf("rotate wrote %q to standard output, want a PDF-document", stdout.Bytes()[:min(stdout.Len(), 8)])
+	}
+
+	e, stdout, stderr = testEnv()
+	if code := run([]string{"info", merged}, e); code != exitOK {
+		t.Fatalf("info = %d, want %d; stderr: %s", code, exitOK, stderr)
+	}
+	var info documentInfo
+	if err := json.Unmarshal(stdout.Bytes(), &info); err != nil {
+		t.Fatalf("info output %q: %v", stdout, err)
+	}
+	if info.File != merged || info.Pages != 3 || info.Encrypted || info.Signed {
+		t.Errorf("info = %+v, want 3 pages of unencrypted, unsigned %s", info, merged)
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	output := fs.String("o", "", "")
+	positional, err := parseFlags(fs, []string{"a.pdf", "-o", "out", "b.pdf", "--", "-c.pdf"})
+	if err != nil {
+		t.Fatalf("parseFlags(): %v", err)
+	}
+	if *output != "out" {
+		t.Errorf("-o = %q, want out", *output)
+	}
+	if want := []string{"a.pdf", "b.pdf", "-c.pdf"}; !reflect.DeepEqual(positional, want) {
+		t.Errorf("positional = %q, want %q", positional, want)
+	}
+}
+
+func TestOutputFor(t *testing.T) {
+	dir := t.TempDir()
+	in := input{name: "docs/report.pdf", path: "docs/report.pdf"}
+	if got, err := outputFor(in, "out.pdf", false, ".pdf"); err != nil || got != "out.pdf" {
+		t.Errorf("outputFor(single) = %q, %v", got, err)
+	}
+	if got, err := outputFor(in, dir, true, ".docx"); err != nil || got != filepath.Join(dir, "report.docx") {
+		t.Errorf("outputFor(multi) = %q, %v", got, err)
+	}
+	file := filepath.Join(dir, "file.pdf")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, output := range []string{"", stdio, file} {
+		if _, err := outputFor(in, output, true, ".pdf"); err == nil {
+			t.Errorf("outputFor(multi, %q) succeeded, want error", output)
+		}
+	}
+	if got := numberedOutput("scan_100%_%d_%d.png", 3); got != "scan_100%_3_%d.png" {
+		t.Errorf("numberedOutput() = %q", got)
+	}
+}
+
+func TestParsers(t *testing.T) {
+	if _, err := parsePdfFormat("pdf/a-2b"); err != nil {
+		t.Errorf("parsePdfFormat(pdf/a-2b): %v", err)
+	}
+	if _, err := parsePermissions("print, extract"); err != nil {
+		t.Errorf("parsePermissions(): %v", err)
+	}
+	if _, err := parsePermissions("print,fly"); err == nil {
+		t.Error("parsePermissions(fly) succeeded, want error")
+	}
+	if x, y, w, h, err := parseRect("10,20,200,50"); err != nil || x != 10 || y != 20 || w != 200 || h != 50 {
+		t.Errorf("parseRect() = %d, %d, %d, %d, %v", x, y, w, h, err)
+	}
+	if got := formatFromExt("out.TIF"); got != "tiff" {
+		t.Errorf("formatFromExt(out.TIF) = %q, want tiff", got)
+	}
+	if got := formatFromExt("page.htm"); got != "html" {
+		t.Errorf("formatFromExt(page.htm) = %q, want html", got)
+	}
+}
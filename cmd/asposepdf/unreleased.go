@@ -0,0 +1,62 @@
+//go:build asposepdf_unreleased
+
+// Commands and info fields using the wrappers of unreleased native functions,
+// built with -tags asposepdf_unreleased like the asposepdf package.
+
+package main
+
+import (
+	"time"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+func init() {
+	saveFormats["html"] = saveFormat{".html", func(pdf *asposepdf.Document, filename string) error {
+		return pdf.SaveHtml(filename, nil)
+	}}
+}
+
+// signatureSummary is a signature in the JSON output of info.
+type signatureSummary struct {
+	Name        string    `json:"name"`
+	SignerName  string    `json:"signername"`
+	SigningTime time.Time `json:"signingtime"`
+	SubFilter   string    `json:"subfilter"`
+}
+
+// unreleasedInfo holds the info fields of unreleased native functions.
+type unreleasedInfo struct {
+	Encryption *asposepdf.EncryptionInfo  `json:"encryption,omitempty"`
+	Signatures []signatureSummary         `json:"signatures,omitempty"`
+	Revisions  int                        `json:"revisions,omitempty"`
+	PageLabels []asposepdf.PageLabelRange `json:"pagelabels,omitempty"`
+	Fonts      []asposepdf.FontInfo       `json:"fonts,omitempty"`
+}
+
+// collect fills the fields from pdf.
+func (info *unreleasedInfo) collect(pdf *asposepdf.Document) error {
+	var err error
+	if info.Encryption, err = pdf.EncryptionInfo(); err != nil {
+		return err
+	}
+	signatures, err := pdf.Signatures()
+	if err != nil {
+		return err
+	}
+	for _, signature := range signatures {
+		info.Signatures = append(info.Signatures, signatureSummary{signature.Name, signature.SignerName, signature.SigningTime, signature.SubFilter})
+	}
+	revisions, err := pdf.Revisions()
+	if err != nil {
+		return err
+	}
+	info.Revisions = len(revisions)
+	if info.PageLabels, err = pdf.PageLabels(); err != nil {
+		return err
+	}
+	if info.Fonts, err = pdf.Fonts(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+//go:build !asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+
+// unreleasedInfo holds no fields without the wrappers of unreleased native functions.
+type unreleasedInfo struct{}
+
+func (info *unreleasedInfo) collect(pdf *asposepdf.Document) error {
+	return nil
+}
@@ -0,0 +1,254 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// Minimal RFC 5652 SignedData construction for detached PDF signatures.
+
+var (
+	oidData              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidSignedData        = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidAttrContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidAttrMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	oidAttrSigningTime   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+	oidAttrSigningCertV2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 47}
+	oidAttrTimestamp     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 14}
+	oidTSTInfo           = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	oidRSAEncryption     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidEd25519           = asn1.ObjectIdentifier{1, 3, 101, 112}
+)
+
+var cmsDigestAlgorithms = map[crypto.Hash]asn1.ObjectIdentifier{
+	crypto.SHA256: {2, 16, 840, 1, 101, 3, 4, 2, 1},
+	crypto.SHA384: {2, 16, 840, 1, 101, 3, 4, 2, 2},
+	crypto.SHA512: {2, 16, 840, 1, 101, 3, 4, 2, 3},
+}
+
+var cmsECDSAAlgorithms = map[crypto.Hash]asn1.ObjectIdentifier{
+	crypto.SHA256: {1, 2, 840, 10045, 4, 3, 2},
+	crypto.SHA384: {1, 2, 840, 10045, 4, 3, 3},
+	crypto.SHA512: {1, 2, 840, 10045, 4, 3, 4},
+}
+
+type cmsContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue
+}
+
+type cmsSignedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo cmsEncapContentInfo
+	Certificates     asn1.RawValue
+	SignerInfos      []cmsSignerInfo `asn1:"set"`
+}
+
+type cmsEncapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,optional,tag:0"`
+}
+
+type cmsSignerInfo struct {
+	Version            int
+	SID                cmsIssuerAndSerial
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type cmsIssuerAndSerial struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+// cmsAttribute is a CMS attribute with a single value.
+type cmsAttribute struct {
+	Type  asn1.ObjectIdentifier
+	Value any
+}
+
+// cmsSigner holds everything needed to produce a CMS signature.
+// The signature is detached unless content is set.
+type cmsSigner struct {
+	signer        crypto.Signer
+	chain         []*x509.Certificate
+	hash          crypto.Hash
+	contentType   asn1.ObjectIdentifier
+	content       []byte
+	signingTime   time.Time
+	signedAttrs   []cmsAttribute
+	unsignedAttrs func(signature []byte) ([]cmsAttribute, error)
+}
+
+// sign returns a DER-encoded CMS SignedData over the content with the given digest.
+func (s *cmsSigner) sign(digest []byte) ([]byte, error) {
+	if s.signer == nil {
+		return nil, errors.New("signer is nil")
+	}
+	if len(s.chain) == 0 {
+		return nil, errors.New("certificate chain is empty")
+	}
+	digestOID, ok := cmsDigestAlgorithms[s.hash]
+	if !ok {
+		return nil, fmt.Errorf("unsupported hash algorithm %v", s.hash)
+	}
+	if _, ok := s.signer.Public().(ed25519.PublicKey); ok && s.hash != crypto.SHA512 {
+		return nil, fmt.Errorf("Ed25519 requires SHA-512 as digest algorithm, got %v", s.hash)
+	}
+	digestAlgorithm := pkix.AlgorithmIdentifier{Algorithm: digestOID, Parameters: asn1.NullRawValue}
+	contentType := s.contentType
+	if contentType == nil {
+		contentType = oidData
+	}
+
+	attrs := []cmsAttribute{
+		{oidAttrContentType, contentType},
+		{oidAttrMessageDigest, digest},
+	}
+	if !s.signingTime.IsZero() {
+		attrs = append(attrs, cmsAttribute{oidAttrSigningTime, s.signingTime.UTC()})
+	}
+	attrs = append(attrs, s.signedAttrs...)
+	signedAttrs, err := marshalAttributes(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature is computed over the DER encoding of the attributes as a SET OF.
+	signed := append([]byte{0x31}, signedAttrs.FullBytes[1:]...)
+	signatureAlgorithm, signature, err := s.signBytes(signed)
+	if err != nil {
+		return nil, err
+	}
+
+	info := cmsSignerInfo{
+		Version: 1,
+		SID: cmsIssuerAndSerial{
+			Issuer:       asn1.RawValue{FullBytes: s.chain[0].RawIssuer},
+			SerialNumber: s.chain[0].SerialNumber,
+		},
+		DigestAlgorithm:    digestAlgorithm,
+		SignedAttrs:        signedAttrs,
+		SignatureAlgorithm: signatureAlgorithm,
+		Signature:          signature,
+	}
+	if s.unsignedAttrs != nil {
+		unsigned, err := s.unsignedAttrs(signature)
+		if err != nil {
+			return nil, err
+		}
+		if len(unsigned) > 0 {
+			raw, err := marshalAttributes(unsigned)
+			if err != nil {
+				return nil, err
+			}
+			raw.Tag = 1
+			raw.FullBytes = append([]byte{0xa1}, raw.FullBytes[1:]...)
+			info.UnsignedAttrs = raw
+		}
+	}
+
+	var certs []byte
+	for _, cert := range s.chain {
+		certs = append(certs, cert.Raw...)
+	}
+
+	version := 1
+	if !contentType.Equal(oidData) {
+		version = 3
+	}
+	signedData, err := asn1.Marshal(cmsSignedData{
+		Version:          version,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{digestAlgorithm},
+		EncapContentInfo: cmsEncapContentInfo{EContentType: contentType, EContent: s.content},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: certs},
+		SignerInfos:      []cmsSignerInfo{info},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(cmsContentInfo{
+		ContentType: oidSignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedData},
+	})
+}
+
+// signBytes signs data with the signer's key and returns the CMS signature algorithm.
+func (s *cmsSigner) signBytes(data []byte) (pkix.AlgorithmIdentifier, []byte, error) {
+	switch s.signer.Public().(type) {
+	case *rsa.PublicKey:
+		h := s.hash.New()
+		h.Write(data)
+		signature, err := s.signer.Sign(rand.Reader, h.Sum(nil), s.hash)
+		return pkix.AlgorithmIdentifier{Algorithm: oidRSAEncryption, Parameters: asn1.NullRawValue}, signature, err
+	case *ecdsa.PublicKey:
+		h := s.hash.New()
+		h.Write(data)
+		signature, err := s.signer.Sign(rand.Reader, h.Sum(nil), s.hash)
+		return pkix.AlgorithmIdentifier{Algorithm: cmsECDSAAlgorithms[s.hash]}, signature, err
+	case ed25519.PublicKey:
+		signature, err := s.signer.Sign(rand.Reader, data, crypto.Hash(0))
+		return pkix.AlgorithmIdentifier{Algorithm: oidEd25519}, signature, err
+	default:
+		return pkix.AlgorithmIdentifier{}, nil, fmt.Errorf("unsupported public key type %T", s.signer.Public())
+	}
+}
+
+// marshalAttributes encodes attributes as an implicitly tagged [0] SET OF in DER order.
+func marshalAttributes(attrs []cmsAttribute) (asn1.RawValue, error) {
+	encoded := make([][]byte, 0, len(attrs))
+	for _, attr := range attrs {
+		value, err := asn1.Marshal(attr.Value)
+		if err != nil {
+			return asn1.RawValue{}, err
+		}
+		der, err := asn1.Marshal(struct {
+			Type   asn1.ObjectIdentifier
+			Values asn1.RawValue
+		}{attr.Type, asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: value}})
+		if err != nil {
+			return asn1.RawValue{}, err
+		}
+		encoded = append(encoded, der)
+	}
+	sort.Slice(encoded, func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 })
+
+	raw := asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: bytes.Join(encoded, nil)}
+	der, err := asn1.Marshal(raw)
+	if err != nil {
+		return asn1.RawValue{}, err
+	}
+	raw.FullBytes = der
+	return raw, nil
+}
+
+// signingCertificateV2 returns the ESS signing-certificate-v2 attribute for cert, required by PAdES.
+func signingCertificateV2(cert *x509.Certificate) cmsAttribute {
+	certHash := sha256.Sum256(cert.Raw)
+	type essCertIDv2 struct {
+		CertHash []byte
+	}
+	type signingCertificate struct {
+		Certs []essCertIDv2
+	}
+	return cmsAttribute{oidAttrSigningCertV2, signingCertificate{Certs: []essCertIDv2{{CertHash: certHash[:]}}}}
+}
@@ -1,10 +1,15 @@
 package asposepdf
 
+import "errors"
+
 // Meaning no error.
 const (
 	ERR_OK = ""
 )
 
+// errNotSupported is returned by the stubs of native functions the pinned native library does not export yet.
+var errNotSupported = errors.New("not supported by the native library")
+
 // Enumeration of possible rotation values.
 const (
 	RotationNone  int32 = 0 // Non-rotated.
@@ -94,3 +99,28 @@ const (
 	AssembleDocument               Permissions = 1 << 10 // 1024
 	PrintingQuality                Permissions = 1 << 11 // 2048
 )
+
+// Enumeration of possible font types.
+type FontType int32
+
+const (
+	FontUnknown  FontType = iota // Unknown or unsupported font type.
+	FontType1                    // Type 1 font, including Type 1 (CFF) and multiple master fonts.
+	FontTrueType                 // TrueType font.
+	FontType0                    // Type 0 composite font with a CIDFont descendant.
+	FontType3                    // Type 3 font defined by PDF content streams.
+)
+
+// Enumeration of possible relationships of an attached file to the PDF-document (AFRelationship).
+type AFRelationship int32
+
+const (
+	AFUnspecified      AFRelationship = iota // Relationship is not known or cannot be described by the other values.
+	AFSource                                 // Original source material of the content.
+	AFData                                   // Information used to derive a visual presentation, e.g. a table or a graph.
+	AFAlternative                            // Alternative representation of the content, e.g. an e-invoice XML.
+	AFSupplement                             // Supplemental representation of the original source or data.
+	AFEncryptedPayload                       // Encrypted payload document.
+	AFFormData                               // Data associated with form fields of the document.
+	AFSchema                                 // Schema definition of associated objects.
+)
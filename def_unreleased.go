@@ -0,0 +1,94 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+// Enumeration of possible actions for form fields with the same name while merging.
+type FieldNameConflict int32
+
+const (
+	FieldNameRename  FieldNameConflict = iota // Rename conflicting fields by adding a unique suffix.
+	FieldNameMerge                            // Join conflicting fields into one field with a shared value.
+	FieldNameFlatten                          // Flatten conflicting fields into static content.
+)
+
+// Enumeration of possible certification (DocMDP) levels of a signature.
+type CertificationLevel int32
+
+const (
+	NotCertified         CertificationLevel = iota // Approval signature, the document is not certified.
+	CertifiedNoChanges                             // Certified, no changes are allowed.
+	CertifiedFormFilling                           // Certified, filling forms and signing are allowed.
+	CertifiedAnnotations                           // Certified, filling forms, signing and annotating are allowed.
+)
+
+// Enumeration of possible PAdES baseline signature profiles.
+type PAdESProfile int32
+
+const (
+	PAdES_B_B   PAdESProfile = iota // PAdES B-B: basic CAdES-based signature.
+	PAdES_B_T                       // PAdES B-T: B-B with a signature timestamp.
+	PAdES_B_LT                      // PAdES B-LT: B-T with validation data in the DSS dictionary.
+	PAdES_B_LTA                     // PAdES B-LTA: B-LT with a document timestamp.
+)
+
+// Enumeration of possible field lock actions applied when a signature field is signed.
+type FieldLockAction int32
+
+const (
+	LockNone    FieldLockAction = iota // No fields are locked.
+	LockAll                            // All fields of the document are locked.
+	LockInclude                        // Only the listed fields are locked.
+	LockExclude                        // All fields except the listed ones are locked.
+)
+
+// Enumeration of possible credentials a PDF-document was opened with.
+type PasswordType int32
+
+const (
+	PasswordNone        PasswordType = iota // The document is not encrypted or opened without a password.
+	PasswordUser                            // Opened with the user password.
+	PasswordOwner                           // Opened with the owner password.
+	PasswordCertificate                     // Opened with a recipient certificate (public-key security).
+)
+
+// Enumeration of possible positions of stamps on a page.
+type Position int32
+
+const (
+	PositionAuto         Position = iota // Default position of the stamp type.
+	PositionCenter                       // Center of the page.
+	PositionTopLeft                      // Top left corner.
+	PositionTopCenter                    // Top edge, centered.
+	PositionTopRight                     // Top right corner.
+	PositionBottomLeft                   // Bottom left corner.
+	PositionBottomCenter                 // Bottom edge, centered.
+	PositionBottomRight                  // Bottom right corner.
+)
+
+// Enumeration of possible numbering styles.
+type NumberingStyle int32
+
+const (
+	NumberingDecimal      NumberingStyle = iota // Decimal arabic numerals: 1, 2, 3.
+	NumberingRomanUpper                         // Uppercase roman numerals: I, II, III.
+	NumberingRomanLower                         // Lowercase roman numerals: i, ii, iii.
+	NumberingLettersUpper                       // Uppercase letters: A to Z, then AA to ZZ.
+	NumberingLettersLower                       // Lowercase letters: a to z, then aa to zz.
+	NumberingNone                               // No number, only the prefix of a page label; not valid for AddPageNumbers.
+)
+
+// Enumeration of possible e-invoice profiles (ZUGFeRD / Factur-X conformance levels).
+type EInvoiceProfile int32
+
+const (
+	EInvoiceAuto      EInvoiceProfile = iota // Profile detected from the invoice XML.
+	EInvoiceMinimum                          // Factur-X / ZUGFeRD 2 MINIMUM.
+	EInvoiceBasicWL                          // Factur-X / ZUGFeRD 2 BASIC WL.
+	EInvoiceBasic                            // Factur-X / ZUGFeRD 2 BASIC.
+	EInvoiceEN16931                          // Factur-X / ZUGFeRD 2 EN 16931 (COMFORT).
+	EInvoiceExtended                         // Factur-X / ZUGFeRD 2 EXTENDED.
+	EInvoiceXRechnung                        // ZUGFeRD 2 XRECHNUNG.
+	ZUGFeRD1Basic                            // ZUGFeRD 1.0 BASIC.
+	ZUGFeRD1Comfort                          // ZUGFeRD 1.0 COMFORT.
+	ZUGFeRD1Extended                         // ZUGFeRD 1.0 EXTENDED.
+)
@@ -6,7 +6,7 @@
 //
 //	PDF Processing
 //	 Main core operation: New, Open, Save, SaveAs, Close, SetLicense, Append, AppendPages, MergeDocuments, SplitDocument, Split, SplitAtPage, SplitAt
-//	 Other core operation: WordCount, CharacterCount, Bytes
+//	 Other core operation: Inspect, WordCount, CharacterCount, Bytes
 //	 Page main core operation: Add, Insert, Delete, Count
 //	 Page other core operation: WordCount, CharacterCount, IsBlank
 //	 Organize: Optimize, OptimizeResource, OptimizeFileSize, Grayscale, Rotate, SetBackground, Repair, Flatten, AddPageNum, AddTextHeader, AddTextFooter, AddWatermark, ReplaceText, Crop
@@ -15,6 +15,9 @@
 //	 Page remove operation: PageRemoveAnnotations, PageRemoveHiddenText, PageRemoveImages, PageRemoveTables, PageRemoveWatermarks, PageRemoveTextHeaders, PageRemoveTextFooters
 //	 Font operation: ReplaceFont, PageReplaceFont, EmbedFonts and UnembedFonts
 //	 Others: Get contents as plain text
+//	 Processing pipelines: YAML/JSON recipes of operations with validation and per-step reports
+//	 HTTP server: multipart REST endpoints for conversion, rendering, merge/split, optimize, validate, sign and text with worker pool and metrics
+//	 Command-line tool: asposepdf with merge, split, rotate, encrypt, decrypt, convert, validate, optimize, watermark, sign, info, text, render
 //
 //	PDF converting and saving
 //	 Microsoft Office: DOC, DOCX, XLSX, PPTX, DOCX with Enhanced Recognition Mode (fully editable tables and paragraphs)
@@ -23,7 +26,7 @@
 //	 Export with AcroForm: FDF, XFDF, XML
 //
 //      PDF Compliance
-//       Convert and validate PDF for a specific standard: Convert, Validate
+//       Convert and validate PDF for a specific standard: Convert, Validate, ConvertWithReport, ValidateWithReport
 //       Remove PDF/A and PDF/UA compliance: RemovePdfaCompliance, RemovePdfUaCompliance
 //       Check PDF/A and PDF/UA compliance: IsPdfaCompliant, IsPdfUaCompliant
 //
@@ -128,6 +131,13 @@
 //	The test run from the root package folder:
 //	  go test -v
 //
+// Unreleased native functions
+//
+//	Some functions call native functions that the pinned native library does not export yet.
+//	They are not part of the default build, see README.md for the list.
+//	Build with -tags asposepdf_unreleased against a native library exporting them:
+//	  go test -v -tags asposepdf_unreleased
+//
 // License
 //
 //   - The Go source code is licensed under the MIT License.
@@ -786,6 +786,59 @@ func (document *Document) CharacterCount() (int32, error) {
 	}
 }
 
+// Inspect returns a profile of PDF-document: version, producer, counts, security and compliance status,
+// page sizes and rotations, fonts, images, attachments, forms, JavaScript and layers.
+//
+// Without -tags asposepdf_unreleased the document properties and fonts are not supported,
+// their fields are left empty and the counts, security and compliance status are still returned.
+//
+// Example:
+//
+//	report, err := pdf.Inspect()
+//	data, err := json.Marshal(report)
+func (document *Document) Inspect() (*InspectionReport, error) {
+	report, e := document.properties()
+	if errors.Is(e, errNotSupported) {
+		report, e = &InspectionReport{}, nil
+	}
+	if e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.PageCount, e = document.PageCount(); e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.WordCount, e = document.WordCount(); e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.CharacterCount, e = document.CharacterCount(); e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.Encrypted, e = document.IsEncrypted(); e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.Permissions, e = document.GetPermissions(); e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.Signed, e = document.IsSigned(); e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.PdfA, e = document.IsPdfaCompliant(); e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.PdfUA, e = document.IsPdfUaCompliant(); e != nil {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	if report.Fonts, e = document.fonts(); e != nil && !errors.Is(e, errNotSupported) {
+		return nil, fmt.Errorf("Inspect(): %w", e)
+	}
+	for i := range report.Pages {
+		if report.Pages[i].Blank, e = document.PageIsBlank(report.Pages[i].Number); e != nil {
+			return nil, fmt.Errorf("Inspect(): page %d: %w", report.Pages[i].Number, e)
+		}
+	}
+	return report, nil
+}
+
 // ReplaceText replaces text in PDF-document.
 //
 // Example:
@@ -1639,6 +1692,56 @@ func (document *Document) Convert(pdfFormat PdfFormat, action ConvertErrorAction
 	return success != 0, logStr, nil
 }
 
+// ValidateWithReport validates a PDF-document for compliance with the PDF format
+// and returns the problems of the log as a structured report.
+//
+// Example:
+//
+//	report, err := pdf.ValidateWithReport(asposepdf.PDF_A_1B)
+//	for _, issue := range report.Issues {
+//		fmt.Printf("%s page %d: %s\n", issue.Clause, issue.Page, issue.Message)
+//	}
+func (document *Document) ValidateWithReport(pdfFormat PdfFormat) (*ValidationReport, error) {
+	valid, log, err := document.Validate(pdfFormat)
+	if err != nil {
+		return nil, err
+	}
+	report, err := newValidationReport(pdfFormat, valid, log)
+	if err != nil {
+		return nil, fmt.Errorf("ValidateWithReport(): %w", err)
+	}
+	return report, nil
+}
+
+// ConvertWithReport converts a PDF-document into the PDF format and returns the problems of the log
+// as a structured report. The document is validated after the conversion: Valid is the result
+// of the validation and, if it passed, the issues it no longer reports are marked as Fixed.
+//
+// Example:
+//
+//	report, err := pdf.ConvertWithReport(asposepdf.PDF_A_1B, asposepdf.Delete)
+//	remaining := report.Remaining()
+func (document *Document) ConvertWithReport(pdfFormat PdfFormat, action ConvertErrorAction) (*ValidationReport, error) {
+	success, log, err := document.Convert(pdfFormat, action)
+	if err != nil {
+		return nil, err
+	}
+	report, err := newValidationReport(pdfFormat, success, log)
+	if err != nil {
+		return nil, fmt.Errorf("ConvertWithReport(): %w", err)
+	}
+	valid, log, err := document.Validate(pdfFormat)
+	if err != nil {
+		return nil, fmt.Errorf("ConvertWithReport(): %w", err)
+	}
+	remaining, err := newValidationReport(pdfFormat, valid, log)
+	if err != nil {
+		return nil, fmt.Errorf("ConvertWithReport(): %w", err)
+	}
+	report.markFixed(remaining)
+	return report, nil
+}
+
 // PageCount returns page count in PDF-document.
 //
 // Example:
@@ -0,0 +1,227 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrNoEInvoice is returned by ExtractEInvoice if PDF-document has no e-invoice attachment.
+var ErrNoEInvoice = errors.New("no e-invoice attachment")
+
+// Namespaces of the root element of the invoice XML.
+const (
+	ciiNamespace      = "urn:un:unece:uncefact:data:standard:CrossIndustryInvoice:100" // Factur-X / ZUGFeRD 2
+	zugferd1Namespace = "urn:ferd:CrossIndustryDocument:invoice:1p0"                   // ZUGFeRD 1.0
+)
+
+// eInvoiceSchema describes the XMP extension schema of an e-invoice.
+type eInvoiceSchema struct {
+	namespace string
+	prefix    string
+	name      string
+}
+
+var (
+	facturXSchema  = eInvoiceSchema{"urn:factur-x:pdfa:CrossIndustryDocument:invoice:1p0#", "fx", "Factur-X PDFA Extension Schema"}
+	zugferd1Schema = eInvoiceSchema{"urn:ferd:pdfa:CrossIndustryDocument:invoice:1p0#", "zf", "ZUGFeRD PDFA Extension Schema"}
+)
+
+// eInvoiceProfile describes how an e-invoice profile is identified and embedded.
+// MINIMUM and BASIC WL invoices are not complete invoices, so their XML is attached as AFData.
+type eInvoiceProfile struct {
+	guideline        string         // Guideline ID of the invoice XML, a prefix for XRechnung
+	conformanceLevel string         // ConformanceLevel of the XMP metadata
+	filename         string         // Name of the attached invoice XML
+	root             string         // Namespace of the root element
+	relationship     AFRelationship // AFRelationship of the attached invoice XML
+	schema           eInvoiceSchema
+}
+
+var eInvoiceProfiles = map[EInvoiceProfile]eInvoiceProfile{
+	EInvoiceMinimum:   {"urn:factur-x.eu:1p0:minimum", "MINIMUM", "factur-x.xml", ciiNamespace, AFData, facturXSchema},
+	EInvoiceBasicWL:   {"urn:factur-x.eu:1p0:basicwl", "BASIC WL", "factur-x.xml", ciiNamespace, AFData, facturXSchema},
+	EInvoiceBasic:     {"urn:cen.eu:en16931:2017#compliant#urn:factur-x.eu:1p0:basic", "BASIC", "factur-x.xml", ciiNamespace, AFAlternative, facturXSchema},
+	EInvoiceEN16931:   {"urn:cen.eu:en16931:2017", "EN 16931", "factur-x.xml", ciiNamespace, AFAlternative, facturXSchema},
+	EInvoiceExtended:  {"urn:cen.eu:en16931:2017#conformant#urn:factur-x.eu:1p0:extended", "EXTENDED", "factur-x.xml", ciiNamespace, AFAlternative, facturXSchema},
+	EInvoiceXRechnung: {"urn:cen.eu:en16931:2017#compliant#urn:", "XRECHNUNG", "xrechnung.xml", ciiNamespace, AFAlternative, facturXSchema},
+	ZUGFeRD1Basic:     {"urn:ferd:CrossIndustryDocument:invoice:1p0:basic", "BASIC", "ZUGFeRD-invoice.xml", zugferd1Namespace, AFAlternative, zugferd1Schema},
+	ZUGFeRD1Comfort:   {"urn:ferd:CrossIndustryDocument:invoice:1p0:comfort", "COMFORT", "ZUGFeRD-invoice.xml", zugferd1Namespace, AFAlternative, zugferd1Schema},
+	ZUGFeRD1Extended:  {"urn:ferd:CrossIndustryDocument:invoice:1p0:extended", "EXTENDED", "ZUGFeRD-invoice.xml", zugferd1Namespace, AFAlternative, zugferd1Schema},
+}
+
+// eInvoiceFilenames are names of invoice attachments recognized by ExtractEInvoice, compared case-insensitively.
+var eInvoiceFilenames = []string{"factur-x.xml", "zugferd-invoice.xml", "xrechnung.xml"}
+
+// detectEInvoiceProfile returns the profile of the invoice XML from its guideline ID.
+func detectEInvoiceProfile(data []byte) (EInvoiceProfile, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	root := ""
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return EInvoiceAuto, errors.New("invoice XML has no GuidelineSpecifiedDocumentContextParameter")
+		}
+		if err != nil {
+			return EInvoiceAuto, fmt.Errorf("invalid invoice XML: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if root == "" {
+			root = start.Name.Space
+			if root != ciiNamespace && root != zugferd1Namespace {
+				return EInvoiceAuto, fmt.Errorf("invoice XML root %s is not a Cross Industry Invoice", start.Name.Local)
+			}
+			continue
+		}
+		if start.Name.Local != "GuidelineSpecifiedDocumentContextParameter" {
+			continue
+		}
+		var parameter struct {
+			ID string `xml:"ID"`
+		}
+		if err := decoder.DecodeElement(&parameter, &start); err != nil {
+			return EInvoiceAuto, fmt.Errorf("invalid invoice XML: %w", err)
+		}
+		guideline := strings.TrimSpace(parameter.ID)
+		for profile, p := range eInvoiceProfiles {
+			if p.root == root && guideline == p.guideline {
+				return profile, nil
+			}
+		}
+		// XRechnung guidelines carry the version, e.g. urn:cen.eu:en16931:2017#compliant#urn:xeinkauf.de:kosit:xrechnung_3.0
+		if root == ciiNamespace && strings.HasPrefix(guideline, eInvoiceProfiles[EInvoiceXRechnung].guideline) && strings.Contains(guideline, "xrechnung") {
+			return EInvoiceXRechnung, nil
+		}
+		return EInvoiceAuto, fmt.Errorf("unknown invoice guideline %q", guideline)
+	}
+}
+
+// xmp returns rdf:Description elements with the e-invoice properties and the PDF/A extension schema describing them.
+func (p eInvoiceProfile) xmp() string {
+	var b strings.Builder
+	s := p.schema
+	fmt.Fprintf(&b, `<rdf:Description rdf:about="" xmlns:%s="%s">`, s.prefix, s.namespace)
+	fmt.Fprintf(&b, `<%[1]s:DocumentType>INVOICE</%[1]s:DocumentType>`, s.prefix)
+	fmt.Fprintf(&b, `<%[1]s:DocumentFileName>%[2]s</%[1]s:DocumentFileName>`, s.prefix, p.filename)
+	fmt.Fprintf(&b, `<%[1]s:Version>1.0</%[1]s:Version>`, s.prefix)
+	fmt.Fprintf(&b, `<%[1]s:ConformanceLevel>%[2]s</%[1]s:ConformanceLevel>`, s.prefix, p.conformanceLevel)
+	b.WriteString(`</rdf:Description>`)
+
+	b.WriteString(`<rdf:Description rdf:about="" xmlns:pdfaExtension="http://www.aiim.org/pdfa/ns/extension/" xmlns:pdfaSchema="http://www.aiim.org/pdfa/ns/schema#" xmlns:pdfaProperty="http://www.aiim.org/pdfa/ns/property#">`)
+	b.WriteString(`<pdfaExtension:schemas><rdf:Bag><rdf:li rdf:parseType="Resource">`)
+	fmt.Fprintf(&b, `<pdfaSchema:schema>%s</pdfaSchema:schema>`, s.name)
+	fmt.Fprintf(&b, `<pdfaSchema:namespaceURI>%s</pdfaSchema:namespaceURI>`, s.namespace)
+	fmt.Fprintf(&b, `<pdfaSchema:prefix>%s</pdfaSchema:prefix>`, s.prefix)
+	b.WriteString(`<pdfaSchema:property><rdf:Seq>`)
+	for _, property := range [][2]string{
+		{"DocumentFileName", "name of the embedded XML invoice file"},
+		{"DocumentType", "INVOICE"},
+		{"Version", "The actual version of the standard"},
+		{"ConformanceLevel", "The conformance level of the embedded invoice data"},
+	} {
+		b.WriteString(`<rdf:li rdf:parseType="Resource">`)
+		fmt.Fprintf(&b, `<pdfaProperty:name>%s</pdfaProperty:name>`, property[0])
+		b.WriteString(`<pdfaProperty:valueType>Text</pdfaProperty:valueType>`)
+		b.WriteString(`<pdfaProperty:category>external</pdfaProperty:category>`)
+		fmt.Fprintf(&b, `<pdfaProperty:description>%s</pdfaProperty:description>`, property[1])
+		b.WriteString(`</rdf:li>`)
+	}
+	b.WriteString(`</rdf:Seq></pdfaSchema:property></rdf:li></rdf:Bag></pdfaExtension:schemas></rdf:Description>`)
+	return b.String()
+}
+
+// CreateEInvoice makes PDF-document a ZUGFeRD / Factur-X e-invoice: converts to PDF/A-3b, attaches the invoice XML
+// as factur-x.xml (xrechnung.xml for XRechnung, ZUGFeRD-invoice.xml for ZUGFeRD 1.0)
+// and adds the e-invoice properties with their XMP extension schema.
+// With EInvoiceAuto the profile is detected from the guideline ID of the XML, otherwise it must match it.
+//
+// Example:
+//
+//	invoice, _ := os.ReadFile("invoice.xml")
+//	err := pdf.CreateEInvoice(invoice, asposepdf.EInvoiceEN16931)
+func (document *Document) CreateEInvoice(invoiceXML []byte, profile EInvoiceProfile) error {
+	detected, e := detectEInvoiceProfile(invoiceXML)
+	if e != nil {
+		return fmt.Errorf("CreateEInvoice(): %w", e)
+	}
+	if profile == EInvoiceAuto {
+		profile = detected
+	}
+	p, ok := eInvoiceProfiles[profile]
+	if !ok {
+		return fmt.Errorf("CreateEInvoice(): invalid profile %d", profile)
+	}
+	if profile != detected {
+		return fmt.Errorf("CreateEInvoice(): profile %s does not match the guideline of the invoice XML", p.conformanceLevel)
+	}
+	if name, e := document.eInvoiceAttachment(); e == nil {
+		return fmt.Errorf("CreateEInvoice(): PDF-document already has an e-invoice attachment %s", name)
+	} else if !errors.Is(e, ErrNoEInvoice) {
+		return fmt.Errorf("CreateEInvoice(): %w", e)
+	}
+	// The document is converted before the invoice is attached, so a failed conversion leaves no attachment behind
+	success, log, e := document.Convert(PDF_A_3B, Delete)
+	if e != nil {
+		return fmt.Errorf("CreateEInvoice(): %w", e)
+	}
+	if !success {
+		return fmt.Errorf("CreateEInvoice(): conversion to PDF/A-3b failed: %s", log)
+	}
+	e = document.AddAttachment(bytes.NewReader(invoiceXML), &AttachmentOptions{
+		Name:         p.filename,
+		Description:  "Invoice",
+		MimeType:     "text/xml",
+		Relationship: p.relationship,
+	})
+	if e != nil {
+		return fmt.Errorf("CreateEInvoice(): %w", e)
+	}
+	if e := document.addXmpMetadata(p.xmp()); e != nil {
+		return fmt.Errorf("CreateEInvoice(): %w", e)
+	}
+	return nil
+}
+
+// ExtractEInvoice returns the invoice XML attached to a ZUGFeRD / Factur-X e-invoice and its profile
+// detected from the guideline ID of the XML, EInvoiceAuto if the guideline is unknown.
+// ErrNoEInvoice is returned if PDF-document has no e-invoice attachment.
+//
+// Example:
+//
+//	invoice, profile, err := pdf.ExtractEInvoice()
+func (document *Document) ExtractEInvoice() ([]byte, EInvoiceProfile, error) {
+	name, e := document.eInvoiceAttachment()
+	if e != nil {
+		return nil, EInvoiceAuto, fmt.Errorf("ExtractEInvoice(): %w", e)
+	}
+	var invoiceXML bytes.Buffer
+	if e := document.ExtractAttachment(name, &invoiceXML); e != nil {
+		return nil, EInvoiceAuto, fmt.Errorf("ExtractEInvoice(): %w", e)
+	}
+	profile, _ := detectEInvoiceProfile(invoiceXML.Bytes())
+	return invoiceXML.Bytes(), profile, nil
+}
+
+// eInvoiceAttachment returns the name of the document-level attachment with a recognized e-invoice file name.
+func (document *Document) eInvoiceAttachment() (string, error) {
+	attachments, e := document.Attachments()
+	if e != nil {
+		return "", e
+	}
+	for _, attachment := range attachments {
+		for _, filename := range eInvoiceFilenames {
+			if attachment.Page == 0 && strings.EqualFold(attachment.Name, filename) {
+				return attachment.Name, nil
+			}
+		}
+	}
+	return "", ErrNoEInvoice
+}
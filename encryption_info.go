@@ -0,0 +1,27 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+// EncryptionInfo contains details of the encryption dictionary of a PDF-document.
+type EncryptionInfo struct {
+	Encrypted       bool            `json:"encrypted"`       // The document is encrypted
+	SecurityHandler string          `json:"securityhandler"` // Security handler: Standard for passwords, Adobe.PubSec for certificates
+	Algorithm       CryptoAlgorithm `json:"algorithm"`       // Crypto algorithm
+	KeyLength       int32           `json:"keylength"`       // Key length in bits
+	Revision        int32           `json:"revision"`        // Revision of the security handler (R)
+	EncryptMetadata bool            `json:"encryptmetadata"` // Document metadata is encrypted
+	Permissions     Permissions     `json:"permissions"`     // Permissions of the user
+	PasswordType    PasswordType    `json:"passwordtype"`    // Credentials the document was opened with
+}
+
+// EncryptionInfo returns details of the encryption of PDF-document.
+//
+// Example:
+//
+//	info, err := pdf.EncryptionInfo()
+//	if info.Algorithm == RC4x40 {
+//		// re-encrypt legacy document
+//	}
+func (document *Document) EncryptionInfo() (*EncryptionInfo, error) {
+	return document.encryptionInfo()
+}
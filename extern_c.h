@@ -21,11 +21,16 @@ extern "C" {
     ASPOSE_PDF_GO_SHARED_API void* PDFDocument_New(const char** error);
     ASPOSE_PDF_GO_SHARED_API void* PDFDocument_Open(const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void* PDFDocument_Open_With_Password(const char* filename, const char *password, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void* PDFDocument_Open_With_Certificate(const char* filename, const uint8_t* certData, int certLen, const uint8_t* keyData, int keyLen, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Release(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_About(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_set_License(void* pdfdocumentclass, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_As(void* pdfdocumentclass, const char* filename, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_Incremental(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_As_Incremental(void* pdfdocumentclass, const char* filename, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_Revisions(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_ExtractRevision(void* pdfdocumentclass, int num, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_ExtractText(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Optimize(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Repair(void* pdfdocumentclass, const char** error);
@@ -34,6 +39,10 @@ extern "C" {
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Flatten(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_RemoveAnnotations(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_RemoveAttachments(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_Attachments(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_get_Attachment(void* pdfdocumentclass, const char* name, unsigned char** bufferOut, int* sizeOut, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddAttachment(void* pdfdocumentclass, const uint8_t* data, int len, const char* options, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_AddFileAttachment(void* pdfdocumentclass, int num, const uint8_t* data, int len, const char* options, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_RemoveBlankPages(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_RemoveBookmarks(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_RemoveHiddenText(void* pdfdocumentclass, const char** error);
@@ -48,14 +57,19 @@ extern "C" {
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Crop(void* pdfdocumentclass, double margin, const char** error);
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_get_WordCount(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_get_CharacterCount(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_Properties(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_ReplaceText(void* pdfdocumentclass, const char* findText, const char* replaceText, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_ReplaceFont(void* pdfdocumentclass, const char* findFontName, const char* replaceFontName, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddPageNum(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddPageNumbers(void* pdfdocumentclass, const char* options, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddTextHeader(void* pdfdocumentclass, const char* header, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddTextFooter(void* pdfdocumentclass, const char* footer, const char** error);
+    ASPOSE_PDF_GO_SHARED_API int PDFDocument_AddHeaderFooter(void* pdfdocumentclass, const char* headerFooter, int isFooter, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddWatermark(void* pdfdocumentclass, const char* text, const char* fontName, double fontSize, const char* foregroundColor, int xPosition, int yPosition, int rotation, int isBackground, double opacity, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddWatermark_Options(void* pdfdocumentclass, const char* options, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_EmbedFonts(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_UnembedFonts(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_Fonts(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_OptimizeFileSize(void* pdfdocumentclass, int imageQuality, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_DocX(void* pdfdocumentclass, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_Doc(void* pdfdocumentclass, const char* filename, const char** error);
@@ -71,13 +85,24 @@ extern "C" {
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_Tiff(void* pdfdocumentclass, int resolutionDPI, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_DocXEnhanced(void* pdfdocumentclass, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_SvgZip(void* pdfdocumentclass, const char* filename, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_Html(void* pdfdocumentclass, const char* filename, const char* options, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Export_Fdf(void* pdfdocumentclass, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Export_Xfdf(void* pdfdocumentclass, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Export_Xml(void* pdfdocumentclass, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Append(void* pdfdocumentclass, const void* otherpdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_AppendPages(void* pdfdocumentclass, const void* otherpdfdocumentclass, const char* pagerange, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void* PDFMerger_New(const char* filename, const char* options, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFMerger_Append_File(void* pdfmergerclass, const char* filename, const char* title, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFMerger_Append_Memory(void* pdfmergerclass, const uint8_t* buffer, int size, const char* title, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFMerger_Close(void* pdfmergerclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFMerger_Release(void* pdfmergerclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFFonts_AddDirectory(const char* dir, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFFonts_AddMemory(const uint8_t* buffer, int size, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFFonts_SetSubstitution(const char* fontName, const char* fallbacks, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFFonts_Reset(const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Save_Memory(void* pdfdocumentclass, unsigned char** bufferOut, int* sizeOut, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Encrypt(void* pdfdocumentclass, const char* userPassword, const char* ownerPassword, int permissions, int cryptoAlgorithm, int usePdf20, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_Encrypt_PubSec(void* pdfdocumentclass, const char* recipients, int cryptoAlgorithm, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Decrypt(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_set_Permissions(void* pdfdocumentclass, const char* userPassword, const char* ownerPassword, int permissions, const char** error);
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_get_Permissions(void* pdfdocumentclass, const char** error);
@@ -85,14 +110,32 @@ extern "C" {
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_is_PdfUaCompliant(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_RemovePdfaCompliance(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_RemovePdfUaCompliance(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API int PDFDocument_is_Tagged(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_StructureTree(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_SetStructureAltText(void* pdfdocumentclass, const char* id, const char* altText, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_set_Language(void* pdfdocumentclass, const char* language, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_set_Title(void* pdfdocumentclass, const char* title, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_AutoTag(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_Convert(void* pdfdocumentclass, const char** outputLog, int pdfFormat, int convertErrorAction, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddXmpMetadata(void* pdfdocumentclass, const char* xmp, const char** error);
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_Validate(void* pdfdocumentclass, const char** outputLog, int pdfFormat, const char** error);
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_is_Encrypted(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_EncryptionInfo(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_is_Signed(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_Signatures(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API int PDFDocument_VerifySignature(void* pdfdocumentclass, const char* name, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddSignatureField(void* pdfdocumentclass, int num, const char* name, const char* options, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_SignatureFields(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_SignPKCS7(void* pdfdocumentclass, int num, const uint8_t* signData, int signLen, const char* pswSign, int setXIndent, int setYIndent, int setHeight, int setWidth, const char* reason, const char* contact, const char* location, int isVisible, const uint8_t* appearanceData, int appearanceLen, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_SignPKCS7Detached(void* pdfdocumentclass, int num, const uint8_t* signData, int signLen, const char* pswSign, int setXIndent, int setYIndent, int setHeight, int setWidth, const char* reason, const char* contact, const char* location, int isVisible, const uint8_t* appearanceData, int appearanceLen, const char* filename, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_Sign(void* pdfdocumentclass, const uint8_t* signData, int signLen, const char* pswSign, const char* options, const char* filename, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_Sign_Memory(void* pdfdocumentclass, const uint8_t* signData, int signLen, const char* pswSign, const char* options, unsigned char** bufferOut, int* sizeOut, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_PrepareSignature(void* pdfdocumentclass, const char* options, const char* filename, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_AddValidationData(void* pdfdocumentclass, const char* data, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_RemoveSigns(void* pdfdocumentclass, const char* filename, const char** error);
     ASPOSE_PDF_GO_SHARED_API int PDFDocument_Page_get_Count(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_get_PageLabels(void* pdfdocumentclass, const char** error);
+    ASPOSE_PDF_GO_SHARED_API void PDFDocument_set_PageLabels(void* pdfdocumentclass, const char* labels, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_Add(void* pdfdocumentclass, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_Insert(void* pdfdocumentclass, int num, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_Delete(void* pdfdocumentclass, int num, const char** error);
@@ -121,6 +164,7 @@ extern "C" {
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_RemoveHiddenText(void* pdfdocumentclass, int num, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_RemoveImages(void* pdfdocumentclass, int num, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_RemoveTables(void* pdfdocumentclass, int num, const char** error);
+    ASPOSE_PDF_GO_SHARED_API const char* PDFDocument_Page_get_Tables(void* pdfdocumentclass, int num, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_RemoveWatermarks(void* pdfdocumentclass, int num, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_RemoveTextHeaders(void* pdfdocumentclass, int num, const char** error);
     ASPOSE_PDF_GO_SHARED_API void PDFDocument_Page_RemoveTextFooters(void* pdfdocumentclass, int num, const char** error);
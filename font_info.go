@@ -0,0 +1,12 @@
+package asposepdf
+
+// FontInfo contains information about a font used by a PDF-document.
+type FontInfo struct {
+	Name     string   `json:"name"`     // Base font name without the subset prefix
+	BaseFont string   `json:"basefont"` // Base font name as stored in the document, e.g. ABCDEF+Arial
+	Type     FontType `json:"type"`     // Font type
+	Embedded bool     `json:"embedded"` // The font program is embedded
+	Subset   bool     `json:"subset"`   // The embedded font program is a subset
+	Encoding string   `json:"encoding"` // Encoding, e.g. WinAnsiEncoding or Identity-H
+	Pages    []int32  `json:"pages"`    // Numbers of pages using the font
+}
@@ -0,0 +1,69 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Font sources and substitution rules are global: they apply to every PDF-document of the process,
+// including rendering, conversions, AddWatermark, AddTextHeader, AddTextFooter and EmbedFonts.
+// Register them before opening documents.
+
+// AddFontDirectory registers a directory with TrueType, OpenType and Type 1 fonts.
+//
+// Example:
+//
+//	err := AddFontDirectory("/usr/share/fonts/corporate")
+func AddFontDirectory(dir string) error {
+	info, e := os.Stat(dir)
+	if e != nil {
+		return fmt.Errorf("AddFontDirectory(): %w", e)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("AddFontDirectory(): %s is not a directory", dir)
+	}
+	return addFontDirectory(dir)
+}
+
+// AddFontData registers an in-memory TrueType or OpenType font.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("CorporateSans.ttf")
+//	err := AddFontData(data)
+func AddFontData(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("AddFontData(): font data is empty")
+	}
+	return addFontData(data)
+}
+
+// SetFontSubstitution sets the fallback chain for fontName: if fontName is not available,
+// the first available font of fallbacks is used. Calling it again for fontName replaces the chain.
+//
+// Example:
+//
+//	err := SetFontSubstitution("Helvetica", "Arial", "Liberation Sans", "DejaVu Sans")
+func SetFontSubstitution(fontName string, fallbacks ...string) error {
+	if fontName == "" || len(fallbacks) == 0 {
+		return errors.New("SetFontSubstitution(): font name and at least one fallback are required")
+	}
+	fallbacks_json, e := json.Marshal(fallbacks)
+	if e != nil {
+		return e
+	}
+	return setFontSubstitution(fontName, string(fallbacks_json))
+}
+
+// ResetFontSources removes registered font directories, in-memory fonts and substitution rules.
+//
+// Example:
+//
+//	err := ResetFontSources()
+func ResetFontSources() error {
+	return resetFontSources()
+}
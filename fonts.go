@@ -0,0 +1,17 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+// Fonts returns information about fonts used by PDF-document.
+//
+// Example:
+//
+//	fonts, err := pdf.Fonts()
+//	for _, font := range fonts {
+//		if !font.Embedded {
+//			// font must be embedded for PDF/A
+//		}
+//	}
+func (document *Document) Fonts() ([]FontInfo, error) {
+	return document.fonts()
+}
@@ -0,0 +1,119 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// HeaderFooter is a template of a page header or footer for AddHeader and AddFooter.
+//
+// Slots may contain placeholders: {page} and {pages} for the page number and the page count,
+// {date} for the current date, {filename} and {title} for the file name and the document title,
+// {bates} for the Bates number of the page.
+//
+// Odd pages are stamped with Left, Center and Right. Even pages are stamped with EvenLeft, EvenCenter
+// and EvenRight if any of them is set, otherwise with the slots of odd pages.
+// MirrorEvenPages swaps the left and right slots of even pages.
+type HeaderFooter struct {
+	Left            string          `json:"left"`            // Text of the left slot
+	Center          string          `json:"center"`          // Text of the center slot
+	Right           string          `json:"right"`           // Text of the right slot
+	EvenLeft        string          `json:"evenleft"`        // Text of the left slot on even pages
+	EvenCenter      string          `json:"evencenter"`      // Text of the center slot on even pages
+	EvenRight       string          `json:"evenright"`       // Text of the right slot on even pages
+	FontName        string          `json:"fontname"`        // Font name, Helvetica if empty
+	FontSize        float64         `json:"fontsize"`        // Font size, 10 if zero
+	Color           string          `json:"color"`           // Text color as #RRGGBB, black if empty
+	MarginLeft      float64         `json:"marginleft"`      // Left margin in points
+	MarginRight     float64         `json:"marginright"`     // Right margin in points
+	MarginVertical  float64         `json:"marginvertical"`  // Distance from the top (header) or bottom (footer) edge in points
+	MirrorEvenPages bool            `json:"mirrorevenpages"` // Swap the left and right slots on even pages
+	SkipFirstPage   bool            `json:"skipfirstpage"`   // Do not stamp the first page of the range
+	PageRange       string          `json:"pagerange"`       // Pages to stamp, e.g. "-2,4,6-8,10-"; all pages if empty
+	DateFormat      string          `json:"-"`               // Go time layout of {date}, 2006-01-02 if empty
+	Bates           *BatesNumbering `json:"bates"`           // Bates numbering for {bates}
+}
+
+// BatesNumbering contains settings of Bates numbers: Prefix, Start zero-padded to Digits, Suffix.
+//
+// To continue the numbering across files, advance Start by the number of pages
+// returned by AddHeader or AddFooter before stamping the next document.
+type BatesNumbering struct {
+	Prefix string `json:"prefix"` // Text before the number
+	Suffix string `json:"suffix"` // Text after the number
+	Start  int64  `json:"start"`  // Number of the first stamped page
+	Digits int32  `json:"digits"` // Minimum number of digits, zero-padded
+}
+
+// evenSlots returns the texts of the left, center and right slots on even pages.
+func (template *HeaderFooter) evenSlots() (left, center, right string) {
+	left, center, right = template.Left, template.Center, template.Right
+	if template.EvenLeft != "" || template.EvenCenter != "" || template.EvenRight != "" {
+		left, center, right = template.EvenLeft, template.EvenCenter, template.EvenRight
+	}
+	if template.MirrorEvenPages {
+		left, right = right, left
+	}
+	return left, center, right
+}
+
+// marshal encodes the template for the native library with {date} already replaced.
+//
+// The slots of even pages are resolved, so the native library stamps the Even slots on even pages
+// and the other slots on odd pages.
+func (template *HeaderFooter) marshal(now time.Time) (string, error) {
+	layout := template.DateFormat
+	if layout == "" {
+		layout = "2006-01-02"
+	}
+	replacer := strings.NewReplacer("{date}", now.Format(layout))
+	resolved := *template
+	resolved.EvenLeft, resolved.EvenCenter, resolved.EvenRight = template.evenSlots()
+	resolved.MirrorEvenPages = false
+	for _, slot := range []*string{&resolved.Left, &resolved.Center, &resolved.Right, &resolved.EvenLeft, &resolved.EvenCenter, &resolved.EvenRight} {
+		*slot = replacer.Replace(*slot)
+	}
+	template_json, err := json.Marshal(&resolved)
+	if err != nil {
+		return "", err
+	}
+	return string(template_json), nil
+}
+
+// AddHeader stamps the header template on pages of PDF-document and returns the number of stamped pages.
+//
+// The template is not modified; to continue Bates numbering in the next document, advance Start by the result.
+//
+// Example:
+//
+//	bates := &BatesNumbering{Prefix: "ACME", Start: 1, Digits: 6}
+//	stamped, err := pdf.AddHeader(&HeaderFooter{Left: "{title}", Right: "{bates}", Bates: bates})
+//	bates.Start += int64(stamped)
+func (document *Document) AddHeader(template *HeaderFooter) (int32, error) {
+	return document.addHeaderFooter(template, false)
+}
+
+// AddFooter stamps the footer template on pages of PDF-document and returns the number of stamped pages.
+//
+// Example:
+//
+//	_, err := pdf.AddFooter(&HeaderFooter{Center: "Page {page} of {pages}", SkipFirstPage: true})
+func (document *Document) AddFooter(template *HeaderFooter) (int32, error) {
+	return document.addHeaderFooter(template, true)
+}
+
+// addHeaderFooter validates and encodes template and stamps it.
+func (document *Document) addHeaderFooter(template *HeaderFooter, isFooter bool) (int32, error) {
+	if template == nil {
+		return 0, errors.New("header/footer template is nil")
+	}
+	template_json, e := template.marshal(time.Now())
+	if e != nil {
+		return 0, e
+	}
+	return document.stampHeaderFooter(template_json, isFooter)
+}
@@ -0,0 +1,69 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// HtmlOptions contains settings for SaveHtml.
+//
+// The zero value saves a single HTML file with embedded images, fonts and CSS in the fixed page layout.
+type HtmlOptions struct {
+	SplitPages     bool `json:"splitpages"`     // Save each page as a separate HTML file with an index.html linking them
+	ExternalImages bool `json:"externalimages"` // Save images as separate files instead of embedding them as data URIs
+	ExternalFonts  bool `json:"externalfonts"`  // Save fonts as separate files instead of embedding them into CSS
+	ExternalCss    bool `json:"externalcss"`    // Save CSS as a separate file instead of a style element
+	FlowingLayout  bool `json:"flowinglayout"`  // Reflowable text instead of absolutely positioned page content
+}
+
+// external reports whether options require files besides the HTML file.
+func (options *HtmlOptions) external() bool {
+	return options.SplitPages || options.ExternalImages || options.ExternalFonts || options.ExternalCss
+}
+
+// marshal returns options as JSON with the output kind of filename:
+// "zip" for a .zip archive, "file" for a single .html or .htm file, "directory" otherwise.
+func (options *HtmlOptions) marshal(filename string) (string, error) {
+	if options == nil {
+		options = &HtmlOptions{}
+	}
+	output := "directory"
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".zip":
+		output = "zip"
+	case ".html", ".htm":
+		if options.external() {
+			return "", errors.New("split pages and external resources require a directory or .zip output")
+		}
+		output = "file"
+	}
+	options_json, err := json.Marshal(struct {
+		*HtmlOptions
+		Output string `json:"output"`
+	}{options, output})
+	if err != nil {
+		return "", err
+	}
+	return string(options_json), nil
+}
+
+// SaveHtml saves previously opened PDF-document as HTML with filename.
+// A filename with the .zip extension produces a ZIP-archive, .html or .htm a single HTML file,
+// any other filename is a directory with index.html and the resources.
+//
+// Example:
+//
+//	err := pdf.SaveHtml("filename.html", nil)
+//	err = pdf.SaveHtml("site.zip", &asposepdf.HtmlOptions{SplitPages: true, ExternalImages: true, ExternalCss: true})
+func (document *Document) SaveHtml(filename string, options *HtmlOptions) error {
+	options_json, e := options.marshal(filename)
+	if e != nil {
+		return fmt.Errorf("SaveHtml(): %w", e)
+	}
+	return document.saveHtml(filename, options_json)
+}
@@ -0,0 +1,33 @@
+package asposepdf
+
+// PageInspection contains the geometry and blank status of a page.
+type PageInspection struct {
+	Number   int32   `json:"number"`   // Page number, starting from 1
+	Width    float64 `json:"width"`    // Width of the page in points
+	Height   float64 `json:"height"`   // Height of the page in points
+	Rotation int32   `json:"rotation"` // Rotation in degrees clockwise: 0, 90, 180 or 270
+	Blank    bool    `json:"blank"`    // The page has no visible content
+}
+
+// InspectionReport contains a profile of a PDF-document returned by Inspect.
+type InspectionReport struct {
+	Version        string           `json:"version"`        // PDF version, e.g. 1.7
+	Producer       string           `json:"producer"`       // Producer from the document information
+	Creator        string           `json:"creator"`        // Creator application from the document information
+	FileSize       int64            `json:"filesize"`       // Size of the source file in bytes, 0 for a new PDF-document
+	PageCount      int32            `json:"pagecount"`      // Number of pages
+	WordCount      int32            `json:"wordcount"`      // Number of words
+	CharacterCount int32            `json:"charactercount"` // Number of characters
+	Encrypted      bool             `json:"encrypted"`      // The document is encrypted
+	Permissions    Permissions      `json:"permissions"`    // Permissions of the user
+	Signed         bool             `json:"signed"`         // The document has digital signatures
+	PdfA           bool             `json:"pdfa"`           // The document claims PDF/A compliance
+	PdfUA          bool             `json:"pdfua"`          // The document claims PDF/UA compliance
+	Pages          []PageInspection `json:"pages"`          // Pages in order
+	Fonts          []FontInfo       `json:"fonts"`          // Fonts used by the document
+	ImageCount     int32            `json:"imagecount"`     // Number of image XObjects on all pages
+	Attachments    []AttachmentInfo `json:"attachments"`    // Embedded files
+	HasForms       bool             `json:"hasforms"`       // The document has an AcroForm or XFA form
+	HasJavaScript  bool             `json:"hasjavascript"`  // The document contains JavaScript actions
+	Layers         []string         `json:"layers"`         // Names of optional content groups (layers)
+}
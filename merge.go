@@ -0,0 +1,125 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"unsafe"
+)
+
+// MergeOptions contains settings for MergeFiles and MergeReaders.
+//
+// The zero value preserves bookmarks and forms of every input and renames conflicting form fields.
+type MergeOptions struct {
+	DiscardBookmarks     bool              `json:"discardbookmarks"`     // Do not copy bookmarks of the inputs
+	BookmarkPerInput     bool              `json:"bookmarkperinput"`     // Add a top-level bookmark for every input
+	DiscardForms         bool              `json:"discardforms"`         // Do not copy form fields of the inputs
+	FieldNameConflict    FieldNameConflict `json:"fieldnameconflict"`    // Action for form fields with the same name
+	TableOfContents      bool              `json:"tableofcontents"`      // Insert a generated table of contents at the beginning
+	TableOfContentsTitle string            `json:"tableofcontentstitle"` // Title of the table of contents page
+	Titles               []string          `json:"-"`                    // Titles of the inputs for bookmarks and table of contents
+}
+
+// MergeError reports the input that failed during MergeFiles or MergeReaders.
+type MergeError struct {
+	Index int    // Index of the failed input
+	Input string // Filename or title of the failed input
+	Err   error  // Underlying error
+}
+
+func (e *MergeError) Error() string {
+	return fmt.Sprintf("failed to append input at index %d (%s): %v", e.Index, e.Input, e.Err)
+}
+
+func (e *MergeError) Unwrap() error {
+	return e.Err
+}
+
+// MergeFiles merges PDF-documents from the input files into a new PDF-document with filename.
+//
+// Inputs are opened, appended and released one by one, so only one input is held in memory at a time.
+// On failure the returned error is a *MergeError containing the index of the failed input.
+//
+// Example:
+//
+//	err := MergeFiles("merged.pdf", []string{"a.pdf", "b.pdf"}, &MergeOptions{TableOfContents: true})
+func MergeFiles(filename string, inputs []string, options *MergeOptions) error {
+	if len(inputs) == 0 {
+		return errors.New("MergeFiles(): no files to merge")
+	}
+
+	m, err := newMerger(filename, options)
+	if err != nil {
+		return fmt.Errorf("MergeFiles(): failed to create merger: %w", err)
+	}
+
+	for i, input := range inputs {
+		title := mergeTitle(options, i, strings.TrimSuffix(filepath.Base(input), filepath.Ext(input)))
+		if err := m.appendFile(input, title); err != nil {
+			m.release()
+			return fmt.Errorf("MergeFiles(): %w", &MergeError{Index: i, Input: input, Err: err})
+		}
+	}
+
+	if err := m.close(); err != nil {
+		return fmt.Errorf("MergeFiles(): failed to save %q: %w", filename, err)
+	}
+	return nil
+}
+
+// MergeReaders merges PDF-documents read from the inputs into a new PDF-document with filename.
+//
+// Inputs are read, appended and released one by one, so only one input is held in memory at a time.
+// On failure the returned error is a *MergeError containing the index of the failed input.
+//
+// Example:
+//
+//	err := MergeReaders("merged.pdf", []io.Reader{file1, file2}, nil)
+func MergeReaders(filename string, inputs []io.Reader, options *MergeOptions) error {
+	if len(inputs) == 0 {
+		return errors.New("MergeReaders(): no readers to merge")
+	}
+
+	m, err := newMerger(filename, options)
+	if err != nil {
+		return fmt.Errorf("MergeReaders(): failed to create merger: %w", err)
+	}
+
+	for i, input := range inputs {
+		title := mergeTitle(options, i, fmt.Sprintf("Document %d", i+1))
+		if input == nil {
+			m.release()
+			return fmt.Errorf("MergeReaders(): %w", &MergeError{Index: i, Input: title, Err: errors.New("reader is nil")})
+		}
+		data, err := io.ReadAll(input)
+		if err == nil {
+			err = m.appendMemory(data, title)
+		}
+		if err != nil {
+			m.release()
+			return fmt.Errorf("MergeReaders(): %w", &MergeError{Index: i, Input: title, Err: err})
+		}
+	}
+
+	if err := m.close(); err != nil {
+		return fmt.Errorf("MergeReaders(): failed to save %q: %w", filename, err)
+	}
+	return nil
+}
+
+// mergeTitle returns the title of the input at index i, falling back to def.
+func mergeTitle(options *MergeOptions, i int, def string) string {
+	if options != nil && i < len(options.Titles) && options.Titles[i] != "" {
+		return options.Titles[i]
+	}
+	return def
+}
+
+// merger writes the merged PDF-document incrementally.
+type merger struct {
+	m unsafe.Pointer
+}
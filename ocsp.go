@@ -0,0 +1,197 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Minimal RFC 6960 OCSP response parsing used for offline revocation checks.
+
+var oidOCSPBasic = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 1}
+
+var ocspHashes = map[string]crypto.Hash{
+	"1.3.14.3.2.26":          crypto.SHA1,
+	"2.16.840.1.101.3.4.2.1": crypto.SHA256,
+	"2.16.840.1.101.3.4.2.2": crypto.SHA384,
+	"2.16.840.1.101.3.4.2.3": crypto.SHA512,
+}
+
+var ocspSignatureAlgorithms = map[string]x509.SignatureAlgorithm{
+	"1.2.840.113549.1.1.5":  x509.SHA1WithRSA,
+	"1.2.840.113549.1.1.11": x509.SHA256WithRSA,
+	"1.2.840.113549.1.1.12": x509.SHA384WithRSA,
+	"1.2.840.113549.1.1.13": x509.SHA512WithRSA,
+	"1.2.840.10045.4.1":     x509.ECDSAWithSHA1,
+	"1.2.840.10045.4.3.2":   x509.ECDSAWithSHA256,
+	"1.2.840.10045.4.3.3":   x509.ECDSAWithSHA384,
+	"1.2.840.10045.4.3.4":   x509.ECDSAWithSHA512,
+	"1.3.101.112":           x509.PureEd25519,
+}
+
+type ocspResponseASN1 struct {
+	Status   asn1.Enumerated
+	Response ocspResponseBytes `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseBytes struct {
+	ResponseType asn1.ObjectIdentifier
+	Response     []byte
+}
+
+type ocspBasicResponse struct {
+	TBSResponseData    ocspResponseData
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          asn1.BitString
+	Certificates       []asn1.RawValue `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspResponseData struct {
+	Raw                asn1.RawContent
+	Version            int `asn1:"optional,default:0,explicit,tag:0"`
+	RawResponderID     asn1.RawValue
+	ProducedAt         time.Time `asn1:"generalized"`
+	Responses          []ocspSingleResponse
+	ResponseExtensions []pkix.Extension `asn1:"explicit,tag:1,optional"`
+}
+
+type ocspSingleResponse struct {
+	CertID           ocspCertID
+	Good             asn1.Flag        `asn1:"tag:0,optional"`
+	Revoked          ocspRevokedInfo  `asn1:"tag:1,optional"`
+	Unknown          asn1.Flag        `asn1:"tag:2,optional"`
+	ThisUpdate       time.Time        `asn1:"generalized"`
+	NextUpdate       time.Time        `asn1:"generalized,explicit,tag:0,optional"`
+	SingleExtensions []pkix.Extension `asn1:"explicit,tag:1,optional"`
+}
+
+type ocspRevokedInfo struct {
+	RevocationTime time.Time       `asn1:"generalized"`
+	Reason         asn1.Enumerated `asn1:"explicit,tag:0,optional"`
+}
+
+type ocspCertID struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	NameHash      []byte
+	IssuerKeyHash []byte
+	SerialNumber  *big.Int
+}
+
+// ocspResponse is a parsed successful OCSP response.
+type ocspResponse struct {
+	basic        ocspBasicResponse
+	certificates []*x509.Certificate
+}
+
+// ocspStatus is the revocation status of one certificate reported by an OCSP response.
+type ocspStatus struct {
+	revoked        bool
+	revocationTime time.Time
+	thisUpdate     time.Time
+	nextUpdate     time.Time
+}
+
+func parseOCSPResponse(der []byte) (*ocspResponse, error) {
+	var resp ocspResponseASN1
+	if rest, err := asn1.Unmarshal(der, &resp); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, errors.New("trailing data after OCSP response")
+	}
+	if resp.Status != 0 {
+		return nil, fmt.Errorf("OCSP response status %d", resp.Status)
+	}
+	if !resp.Response.ResponseType.Equal(oidOCSPBasic) {
+		return nil, errors.New("unsupported OCSP response type")
+	}
+
+	response := &ocspResponse{}
+	if _, err := asn1.Unmarshal(resp.Response.Response, &response.basic); err != nil {
+		return nil, err
+	}
+	for _, raw := range response.basic.Certificates {
+		cert, err := x509.ParseCertificate(raw.FullBytes)
+		if err != nil {
+			return nil, err
+		}
+		response.certificates = append(response.certificates, cert)
+	}
+	return response, nil
+}
+
+// status returns the status of cert if the response covers it and is signed by issuer or its delegated responder.
+func (response *ocspResponse) status(cert, issuer *x509.Certificate) (ocspStatus, bool) {
+	for _, single := range response.basic.TBSResponseData.Responses {
+		if single.CertID.SerialNumber == nil || single.CertID.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+			continue
+		}
+		hash, ok := ocspHashes[single.CertID.HashAlgorithm.Algorithm.String()]
+		if !ok || !hash.Available() {
+			continue
+		}
+		keyHash, err := issuerKeyHash(issuer, hash)
+		if err != nil || !bytes.Equal(keyHash, single.CertID.IssuerKeyHash) {
+			continue
+		}
+		if !response.signedBy(issuer) || bool(single.Unknown) {
+			continue
+		}
+		status := ocspStatus{thisUpdate: single.ThisUpdate, nextUpdate: single.NextUpdate}
+		if !single.Good {
+			status.revoked, status.revocationTime = true, single.Revoked.RevocationTime
+		}
+		return status, true
+	}
+	return ocspStatus{}, false
+}
+
+// signedBy reports whether the response is signed by issuer or by a responder certificate issued by it.
+func (response *ocspResponse) signedBy(issuer *x509.Certificate) bool {
+	algorithm, ok := ocspSignatureAlgorithms[response.basic.SignatureAlgorithm.Algorithm.String()]
+	if !ok {
+		return false
+	}
+	tbs := response.basic.TBSResponseData.Raw
+	signature := response.basic.Signature.RightAlign()
+
+	if issuer.CheckSignature(algorithm, tbs, signature) == nil {
+		return true
+	}
+	for _, responder := range response.certificates {
+		if responder.CheckSignatureFrom(issuer) != nil {
+			continue
+		}
+		delegated := false
+		for _, usage := range responder.ExtKeyUsage {
+			if usage == x509.ExtKeyUsageOCSPSigning {
+				delegated = true
+			}
+		}
+		if delegated && responder.CheckSignature(algorithm, tbs, signature) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// issuerKeyHash returns the hash of the issuer's subjectPublicKey bit string.
+func issuerKeyHash(issuer *x509.Certificate, hash crypto.Hash) ([]byte, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return nil, err
+	}
+	h := hash.New()
+	h.Write(spki.PublicKey.RightAlign())
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,203 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ValidationData contains long-term validation data stored in the DSS dictionary of a PDF-document.
+//
+// If SignatureName is set, the data is also referenced from the VRI entry of that signature,
+// keyed by the SHA-1 hash of its signature value.
+type ValidationData struct {
+	Certificates  []*x509.Certificate `json:"-"`             // Certificates of signers, issuers and TSAs
+	CRLs          [][]byte            `json:"crls"`          // DER-encoded certificate revocation lists
+	OCSPResponses [][]byte            `json:"ocspresponses"` // DER-encoded OCSP responses
+	SignatureName string              `json:"signaturename"` // Name of the signature field to add a VRI entry for; no VRI entry if empty
+}
+
+// MarshalJSON encodes certificates as DER for the native library.
+func (data *ValidationData) MarshalJSON() ([]byte, error) {
+	certificates := make([][]byte, 0, len(data.Certificates))
+	for _, cert := range data.Certificates {
+		certificates = append(certificates, cert.Raw)
+	}
+	type validationData ValidationData
+	return json.Marshal(struct {
+		*validationData
+		Certificates [][]byte `json:"certificates"`
+	}{(*validationData)(data), certificates})
+}
+
+// PAdESOptions contains settings for SignPAdES.
+type PAdESOptions struct {
+	Profile        PAdESProfile     // PAdES baseline profile
+	TSA            TimestampClient  // Time stamping authority, required from PAdES B-T
+	Revocation     RevocationClient // Source of CRLs and OCSP responses for the signer and TSA chains from PAdES B-LT; only ValidationData is used if nil
+	ValidationData ValidationData   // Additional validation data for the DSS dictionary, used from PAdES B-LT
+}
+
+// SignPAdES signs a PDF-document with a PAdES baseline signature and saves it with filename.
+//
+// B-T adds a signature timestamp from the TSA. B-LT adds the signer and TSA certificate chains,
+// their revocation data from PAdESOptions.Revocation and the supplied validation data to the DSS dictionary,
+// referenced from the VRI entry of the signature. B-LTA adds a document timestamp on top.
+// Every step after signing is appended as an incremental update.
+//
+// Example:
+//
+//	err := pdf.SignPAdES(key, chain, &SignOptions{Reason: "Approved"}, &PAdESOptions{
+//		Profile: PAdES_B_LTA,
+//		TSA:        &HTTPTimestampClient{URL: "http://tsa.example.com"},
+//		Revocation: &HTTPRevocationClient{},
+//	}, "signed.pdf")
+func (document *Document) SignPAdES(signer crypto.Signer, chain []*x509.Certificate, options *SignOptions, pades *PAdESOptions, filename string) error {
+	if signer == nil || len(chain) == 0 {
+		return errors.New("SignPAdES(): signer and certificate chain are required")
+	}
+	if pades == nil {
+		pades = &PAdESOptions{}
+	}
+	if pades.Profile >= PAdES_B_T && pades.TSA == nil {
+		return errors.New("SignPAdES(): TSA is required for PAdES B-T and higher profiles")
+	}
+
+	prepared, err := document.prepareExternalSignature(options.forSigner(signer), subFilterCAdESDetached, filename)
+	if err != nil {
+		return fmt.Errorf("SignPAdES(): %w", err)
+	}
+	s := &cmsSigner{
+		signer:      signer,
+		chain:       chain,
+		hash:        prepared.Hash,
+		signedAttrs: []cmsAttribute{signingCertificateV2(chain[0])},
+	}
+	var tsaChain []*x509.Certificate
+	if pades.Profile >= PAdES_B_T {
+		s.unsignedAttrs = func(signature []byte) ([]cmsAttribute, error) {
+			h := prepared.Hash.New()
+			h.Write(signature)
+			token, err := pades.TSA.Timestamp(h.Sum(nil), prepared.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("failed to timestamp signature: %w", err)
+			}
+			if tsaChain, err = timestampChain(token); err != nil {
+				return nil, err
+			}
+			return []cmsAttribute{{oidAttrTimestamp, asn1.RawValue{FullBytes: token}}}, nil
+		}
+	}
+	cms, err := s.sign(prepared.Digest)
+	if err != nil {
+		return fmt.Errorf("SignPAdES(): %w", err)
+	}
+	if err := prepared.Inject(cms); err != nil {
+		return fmt.Errorf("SignPAdES(): %w", err)
+	}
+	if pades.Profile < PAdES_B_LT {
+		return nil
+	}
+
+	signed, err := Open(filename)
+	if err != nil {
+		return fmt.Errorf("SignPAdES(): %w", err)
+	}
+	defer signed.Close()
+
+	data := pades.ValidationData
+	data.Certificates = append(append(append([]*x509.Certificate{}, chain...), tsaChain...), data.Certificates...)
+	if pades.Revocation != nil {
+		for _, certs := range [][]*x509.Certificate{chain, tsaChain} {
+			if err := data.addRevocation(pades.Revocation, certs); err != nil {
+				return fmt.Errorf("SignPAdES(): %w", err)
+			}
+		}
+	}
+	if data.SignatureName, err = signed.signatureName(prepared.ByteRange); err != nil {
+		return fmt.Errorf("SignPAdES(): %w", err)
+	}
+	if err := signed.AddValidationData(&data); err != nil {
+		return fmt.Errorf("SignPAdES(): failed to add validation data: %w", err)
+	}
+	if err := signed.SaveIncremental(); err != nil {
+		return fmt.Errorf("SignPAdES(): %w", err)
+	}
+	if pades.Profile < PAdES_B_LTA {
+		return nil
+	}
+
+	if err := signed.AddDocumentTimestamp(pades.TSA, filename); err != nil {
+		return fmt.Errorf("SignPAdES(): %w", err)
+	}
+	return nil
+}
+
+// AddDocumentTimestamp adds an RFC 3161 document timestamp to PDF-document and saves it with filename.
+//
+// If PDF-document is already signed, the timestamp is appended as an incremental update.
+//
+// Example:
+//
+//	err := pdf.AddDocumentTimestamp(&HTTPTimestampClient{URL: "http://tsa.example.com"}, "timestamped.pdf")
+func (document *Document) AddDocumentTimestamp(tsa TimestampClient, filename string) error {
+	if tsa == nil {
+		return errors.New("AddDocumentTimestamp(): TSA is required")
+	}
+	prepared, err := document.prepareExternalSignature(nil, subFilterRFC3161, filename)
+	if err != nil {
+		return fmt.Errorf("AddDocumentTimestamp(): %w", err)
+	}
+	token, err := tsa.Timestamp(prepared.Digest, prepared.Hash)
+	if err != nil {
+		return fmt.Errorf("AddDocumentTimestamp(): %w", err)
+	}
+	if err := prepared.Inject(token); err != nil {
+		return fmt.Errorf("AddDocumentTimestamp(): %w", err)
+	}
+	return nil
+}
+
+// addRevocation adds the revocation data of every certificate of chain issued by the next one.
+func (data *ValidationData) addRevocation(client RevocationClient, chain []*x509.Certificate) error {
+	for i := 0; i+1 < len(chain); i++ {
+		crls, ocspResponses, err := client.Revocation(chain[i], chain[i+1])
+		if err != nil {
+			return err
+		}
+		data.CRLs = append(data.CRLs, crls...)
+		data.OCSPResponses = append(data.OCSPResponses, ocspResponses...)
+	}
+	return nil
+}
+
+// signatureName returns the name of the signature field with the given byte range.
+func (document *Document) signatureName(byteRange []int64) (string, error) {
+	signatures, err := document.Signatures()
+	if err != nil {
+		return "", err
+	}
+	for _, signature := range signatures {
+		if slices.Equal(signature.ByteRange, byteRange) {
+			return signature.Name, nil
+		}
+	}
+	return "", errors.New("signature not found")
+}
+
+// AddValidationData adds certificates, CRLs and OCSP responses to the DSS dictionary of PDF-document.
+//
+// Save a signed PDF-document with SaveIncremental afterwards to keep existing signatures valid.
+//
+// Example:
+//
+//	err := pdf.AddValidationData(&ValidationData{Certificates: chain, CRLs: [][]byte{crl}})
+func (document *Document) AddValidationData(data *ValidationData) error {
+	return document.addValidationData(data)
+}
@@ -0,0 +1,259 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PageLabelRange defines labels of the pages from StartPage up to the start of the next range.
+//
+// The label of a page is Prefix followed by its number in Style, counting from Start.
+type PageLabelRange struct {
+	StartPage int32          `json:"startpage"` // First physical page of the range, starting from 1
+	Style     NumberingStyle `json:"style"`     // Numbering style
+	Prefix    string         `json:"prefix"`    // Text before the number, e.g. "A-"
+	Start     int32          `json:"start"`     // Number of the first page of the range, 1 if zero
+}
+
+// validatePageLabels checks that ranges start at page 1 and are in ascending order.
+func validatePageLabels(ranges []PageLabelRange) error {
+	for i, r := range ranges {
+		if i == 0 && r.StartPage != 1 {
+			return errors.New("first page label range must start at page 1")
+		}
+		if i > 0 && r.StartPage <= ranges[i-1].StartPage {
+			return fmt.Errorf("page label range at index %d is not in ascending order", i)
+		}
+		if r.Start < 0 {
+			return fmt.Errorf("page label range at index %d has negative start", i)
+		}
+	}
+	return nil
+}
+
+// pageLabel returns the label of page num, or its number if no range covers it.
+func pageLabel(ranges []PageLabelRange, num int32) string {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].StartPage > num }) - 1
+	if i < 0 {
+		return strconv.Itoa(int(num))
+	}
+	r := ranges[i]
+	start := r.Start
+	if start == 0 {
+		start = 1
+	}
+	return r.Prefix + formatNumber(start+num-r.StartPage, r.Style)
+}
+
+// formatNumber returns n in the numbering style.
+func formatNumber(n int32, style NumberingStyle) string {
+	switch style {
+	case NumberingRomanUpper:
+		return romanNumeral(n)
+	case NumberingRomanLower:
+		return strings.ToLower(romanNumeral(n))
+	case NumberingLettersUpper:
+		return letterNumeral(n)
+	case NumberingLettersLower:
+		return strings.ToLower(letterNumeral(n))
+	case NumberingNone:
+		return ""
+	default:
+		return strconv.Itoa(int(n))
+	}
+}
+
+// romanNumeral returns n as uppercase roman numerals.
+func romanNumeral(n int32) string {
+	values := []int32{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+	var b strings.Builder
+	for i, value := range values {
+		for n >= value {
+			b.WriteString(symbols[i])
+			n -= value
+		}
+	}
+	return b.String()
+}
+
+// letterNumeral returns n as uppercase letters as defined for PDF page labels: A to Z, then AA to ZZ, AAA to ZZZ.
+func letterNumeral(n int32) string {
+	if n < 1 {
+		return ""
+	}
+	letter := string(rune('A' + (n-1)%26))
+	return strings.Repeat(letter, int((n-1)/26+1))
+}
+
+// PageLabel returns the label of page num as shown by PDF viewers.
+//
+// Example:
+//
+//	label, err := pdf.PageLabel(1) // "i"
+func (document *Document) PageLabel(num int32) (string, error) {
+	count, err := document.PageCount()
+	if err != nil {
+		return "", err
+	}
+	if num < 1 || num > count {
+		return "", fmt.Errorf("PageLabel(): page %d is out of range 1-%d", num, count)
+	}
+	ranges, err := document.PageLabels()
+	if err != nil {
+		return "", err
+	}
+	return pageLabel(ranges, num), nil
+}
+
+// pageLabelIndex maps labels of the pages to the first page number with that label.
+func (document *Document) pageLabelIndex() (map[string]int32, error) {
+	count, err := document.PageCount()
+	if err != nil {
+		return nil, err
+	}
+	ranges, err := document.PageLabels()
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int32, count)
+	for num := int32(1); num <= count; num++ {
+		label := pageLabel(ranges, num)
+		if _, ok := index[label]; !ok {
+			index[label] = num
+		}
+	}
+	return index, nil
+}
+
+// PageByLabel returns the number of the first page with label.
+//
+// Example:
+//
+//	num, err := pdf.PageByLabel("iv")
+func (document *Document) PageByLabel(label string) (int32, error) {
+	index, err := document.pageLabelIndex()
+	if err != nil {
+		return 0, err
+	}
+	num, ok := index[label]
+	if !ok {
+		return 0, fmt.Errorf("PageByLabel(): no page with label %q", label)
+	}
+	return num, nil
+}
+
+// ResolvePageLabels converts a page range written with page labels into page numbers,
+// to be used with AppendPages, Split and other functions accepting a page range.
+//
+// A part matching no label is taken as a page number, e.g. "7" if no page is labeled "7".
+//
+// Example:
+//
+//	pagerange, err := pdf.ResolvePageLabels("i-iv,1,5-") // "1-4,5,9-"
+func (document *Document) ResolvePageLabels(pagerange string) (string, error) {
+	index, err := document.pageLabelIndex()
+	if err != nil {
+		return "", err
+	}
+	count, err := document.PageCount()
+	if err != nil {
+		return "", err
+	}
+	resolved, err := resolvePageLabels(index, count, pagerange)
+	if err != nil {
+		return "", fmt.Errorf("ResolvePageLabels(): %w", err)
+	}
+	return resolved, nil
+}
+
+// resolvePageLabels converts the labels of pagerange to page numbers using index.
+// Parts matching no label are taken as page numbers from 1 to count.
+func resolvePageLabels(index map[string]int32, count int32, pagerange string) (string, error) {
+	parts := strings.Split(pagerange, ",")
+	for i, part := range parts {
+		resolved, ok := resolvePageLabel(index, count, strings.TrimSpace(part))
+		if !ok {
+			return "", fmt.Errorf("unknown page label %q", strings.TrimSpace(part))
+		}
+		parts[i] = resolved
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// resolvePageLabel converts a single label or a range of labels to page numbers.
+// A label containing '-' is matched as a whole before it is treated as a range.
+func resolvePageLabel(index map[string]int32, count int32, part string) (string, bool) {
+	if num, ok := pageOfLabel(index, count, part); ok {
+		return strconv.Itoa(int(num)), true
+	}
+	for pos := 0; pos < len(part); pos++ {
+		if part[pos] != '-' {
+			continue
+		}
+		from, to := part[:pos], part[pos+1:]
+		fromNum, fromOk := pageOfLabel(index, count, from)
+		toNum, toOk := pageOfLabel(index, count, to)
+		if (fromOk || from == "") && (toOk || to == "") && (fromOk || toOk) {
+			resolved := "-"
+			if fromOk {
+				resolved = strconv.Itoa(int(fromNum)) + resolved
+			}
+			if toOk {
+				resolved += strconv.Itoa(int(toNum))
+			}
+			return resolved, true
+		}
+	}
+	return "", false
+}
+
+// pageOfLabel returns the first page with label, or the page numbered label if no page has it.
+func pageOfLabel(index map[string]int32, count int32, label string) (int32, bool) {
+	if num, ok := index[label]; ok {
+		return num, true
+	}
+	num, err := strconv.ParseInt(label, 10, 32)
+	if err != nil || num < 1 || num > int64(count) {
+		return 0, false
+	}
+	return int32(num), true
+}
+
+// PageLabels returns page label ranges of PDF-document, empty if the document has no page labels.
+//
+// See also: page_label.go
+//
+// Example:
+//
+//	ranges, err := pdf.PageLabels()
+func (document *Document) PageLabels() ([]PageLabelRange, error) {
+	return document.pageLabels()
+}
+
+// SetPageLabels replaces page label ranges of PDF-document; nil removes page labels.
+//
+// The first range must start at page 1, the following ones in ascending order.
+//
+// Example:
+//
+//	err := pdf.SetPageLabels([]PageLabelRange{
+//		{StartPage: 1, Style: NumberingRomanLower},
+//		{StartPage: 5, Style: NumberingDecimal},
+//	})
+func (document *Document) SetPageLabels(ranges []PageLabelRange) error {
+	if e := validatePageLabels(ranges); e != nil {
+		return fmt.Errorf("SetPageLabels(): %w", e)
+	}
+	ranges_json, e := json.Marshal(ranges)
+	if e != nil {
+		return e
+	}
+	return document.setPageLabels(string(ranges_json))
+}
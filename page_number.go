@@ -0,0 +1,43 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// PageNumberOptions contains settings for AddPageNumbers.
+//
+// The zero value stamps decimal numbers starting from 1 at the bottom center of every page.
+type PageNumberOptions struct {
+	Position     Position       `json:"position"`     // Position on the page, bottom center if PositionAuto
+	Format       string         `json:"format"`       // Text with {page} and {pages} placeholders, e.g. "Page {page} of {pages}"; "{page}" if empty
+	Style        NumberingStyle `json:"style"`        // Numbering style of {page} and {pages}, NumberingNone is rejected
+	StartNumber  int32          `json:"startnumber"`  // Number of the first stamped page, 1 if zero
+	FontName     string         `json:"fontname"`     // Font name, Helvetica if empty
+	FontSize     float64        `json:"fontsize"`     // Font size, 10 if zero
+	Color        string         `json:"color"`        // Text color as #RRGGBB, black if empty
+	Margin       float64        `json:"margin"`       // Distance from the page edges in points, 20 if zero
+	PageRange    string         `json:"pagerange"`    // Pages to stamp, e.g. "-2,4,6-8,10-"; all pages if empty
+	ExcludePages string         `json:"excludepages"` // Pages not to stamp, e.g. "1" to skip the cover page
+}
+
+// AddPageNumbers stamps page numbers on pages of PDF-document.
+//
+// Example:
+//
+//	err := pdf.AddPageNumbers(&PageNumberOptions{Position: PositionBottomRight, Format: "Page {page} of {pages}", ExcludePages: "1"})
+func (document *Document) AddPageNumbers(options *PageNumberOptions) error {
+	if options == nil {
+		options = &PageNumberOptions{}
+	}
+	if options.Style == NumberingNone {
+		return errors.New("AddPageNumbers(): NumberingNone is only valid for page labels")
+	}
+	options_json, e := json.Marshal(options)
+	if e != nil {
+		return e
+	}
+	return document.addPageNumbers(string(options_json))
+}
@@ -0,0 +1,318 @@
+package pipeline
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+// job is the input document an action is applied to.
+type job struct {
+	input string // File name of the input
+	step  int    // Index of the running step
+	batch *batch // State shared with the other inputs of the run
+}
+
+// batch is the state shared by the inputs of Run or RunAll.
+type batch struct {
+	next map[int]int64 // Next number of a numbering step by step index
+}
+
+func newBatch() *batch {
+	return &batch{next: map[int]int64{}}
+}
+
+// expand replaces {name} and {dir} in a file name with the base name without extension and the directory of the input.
+func (j *job) expand(filename string) string {
+	base := filepath.Base(j.input)
+	return strings.NewReplacer(
+		"{name}", strings.TrimSuffix(base, filepath.Ext(base)),
+		"{dir}", filepath.Dir(j.input),
+	).Replace(filename)
+}
+
+// action applies a validated step to a document.
+type action func(pdf *asposepdf.Document, j *job) error
+
+// operation reads the parameters of a step and returns its action.
+type operation func(p *params) action
+
+// operations contains the supported operations by Document method name.
+var operations = map[string]operation{
+	"OptimizeFileSize": func(p *params) action {
+		p.required("quality")
+		quality := p.int32("quality", 0)
+		if quality < 1 || quality > 100 {
+			p.fail("quality", "must be from 1 to 100")
+		}
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.OptimizeFileSize(quality) }
+	},
+	"SetBackground": func(p *params) action {
+		p.required("r", "g", "b")
+		r, g, b := p.int32("r", 0), p.int32("g", 0), p.int32("b", 0)
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.SetBackground(r, g, b) }
+	},
+	"Rotate": func(p *params) action {
+		p.required("rotation")
+		rotation := p.enum("rotation", rotations, 0)
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.Rotate(int32(rotation)) }
+	},
+	"Crop": func(p *params) action {
+		p.required("margin")
+		margin := p.float("margin", 0)
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.Crop(margin) }
+	},
+	"ReplaceText": func(p *params) action {
+		p.required("find")
+		find, replace := p.string("find", ""), p.string("replace", "")
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.ReplaceText(find, replace) }
+	},
+	"ReplaceFont": func(p *params) action {
+		p.required("find", "replace")
+		find, replace := p.string("find", ""), p.string("replace", "")
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.ReplaceFont(find, replace) }
+	},
+	"AddTextHeader": func(p *params) action {
+		p.required("text")
+		text := p.string("text", "")
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.AddTextHeader(text) }
+	},
+	"AddTextFooter": func(p *params) action {
+		p.required("text")
+		text := p.string("text", "")
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.AddTextFooter(text) }
+	},
+	"AddWatermark": func(p *params) action {
+		p.required("text")
+		text := p.string("text", "")
+		font := p.string("font", "Helvetica")
+		size := p.float("size", 48)
+		color := p.string("color", "#808080")
+		x, y := p.int32("x", 0), p.int32("y", 0)
+		rotation := p.int32("rotation", 0)
+		background := p.boolean("background", false)
+		opacity := p.float("opacity", 0.5)
+		return func(pdf *asposepdf.Document, j *job) error {
+			return pdf.AddWatermark(text, font, size, color, x, y, rotation, background, opacity)
+		}
+	},
+	"Convert": func(p *params) action {
+		p.required("format")
+		format := asposepdf.PdfFormat(p.enum("format", pdfFormats, 0))
+		onError := asposepdf.ConvertErrorAction(p.enum("action", convertErrorActions, int64(asposepdf.Delete)))
+		return func(pdf *asposepdf.Document, j *job) error {
+			ok, log, err := pdf.Convert(format, onError)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("conversion failed: %s", log)
+			}
+			return nil
+		}
+	},
+	"Validate": func(p *params) action {
+		p.required("format")
+		format := asposepdf.PdfFormat(p.enum("format", pdfFormats, 0))
+		return func(pdf *asposepdf.Document, j *job) error {
+			ok, log, err := pdf.Validate(format)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("document is not compliant: %s", log)
+			}
+			return nil
+		}
+	},
+	"Encrypt": func(p *params) action {
+		user, owner := p.string("user", ""), p.string("owner", "")
+		if user == "" && owner == "" {
+			p.fail("owner", "user or owner password is required")
+		}
+		permissions := asposepdf.Permissions(p.flags("permissions", permissionFlags, 0))
+		algorithm := asposepdf.CryptoAlgorithm(p.enum("algorithm", cryptoAlgorithms, int64(asposepdf.AESx256)))
+		pdf20 := p.boolean("pdf20", false)
+		return func(pdf *asposepdf.Document, j *job) error {
+			return pdf.Encrypt(user, owner, permissions, algorithm, pdf20)
+		}
+	},
+	"SetPermissions": func(p *params) action {
+		user, owner := p.string("user", ""), p.string("owner", "")
+		permissions := asposepdf.Permissions(p.flags("permissions", permissionFlags, 0))
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.SetPermissions(user, owner, permissions) }
+	},
+	"SaveNUp": func(p *params) action {
+		p.required("filename", "columns", "rows")
+		filename := p.string("filename", "")
+		columns, rows := p.int32("columns", 0), p.int32("rows", 0)
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.SaveNUp(j.expand(filename), columns, rows) }
+	},
+	"SaveTiff": func(p *params) action {
+		p.required("filename")
+		filename := p.string("filename", "")
+		dpi := p.int32("dpi", 0)
+		return func(pdf *asposepdf.Document, j *job) error {
+			if dpi > 0 {
+				return pdf.SaveTiff(j.expand(filename), dpi)
+			}
+			return pdf.SaveTiff(j.expand(filename))
+		}
+	},
+	"PageDelete": func(p *params) action {
+		p.required("page")
+		page := p.int32("page", 0)
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.PageDelete(page) }
+	},
+	"PageRotate": func(p *params) action {
+		p.required("page", "rotation")
+		page := p.int32("page", 0)
+		rotation := p.enum("rotation", rotations, 0)
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.PageRotate(page, int32(rotation)) }
+	},
+	"PageSetSize": func(p *params) action {
+		p.required("page", "size")
+		page := p.int32("page", 0)
+		size := p.enum("size", pageSizes, 0)
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.PageSetSize(page, int32(size)) }
+	},
+}
+
+// saveOperations are Document methods saving with a file name.
+var saveOperations = map[string]func(pdf *asposepdf.Document, filename string) error{
+	"SaveAs":           (*asposepdf.Document).SaveAs,
+	"SaveDocX":         (*asposepdf.Document).SaveDocX,
+	"SaveDocXEnhanced": (*asposepdf.Document).SaveDocXEnhanced,
+	"SaveDoc":          (*asposepdf.Document).SaveDoc,
+	"SaveXlsX":         (*asposepdf.Document).SaveXlsX,
+	"SavePptX":         (*asposepdf.Document).SavePptX,
+	"SaveXps":          (*asposepdf.Document).SaveXps,
+	"SaveTxt":          (*asposepdf.Document).SaveTxt,
+	"SaveEpub":         (*asposepdf.Document).SaveEpub,
+	"SaveTeX":          (*asposepdf.Document).SaveTeX,
+	"SaveMarkdown":     (*asposepdf.Document).SaveMarkdown,
+	"SaveBooklet":      (*asposepdf.Document).SaveBooklet,
+	"SaveSvgZip":       (*asposepdf.Document).SaveSvgZip,
+	"ExportFdf":        (*asposepdf.Document).ExportFdf,
+	"ExportXfdf":       (*asposepdf.Document).ExportXfdf,
+	"ExportXml":        (*asposepdf.Document).ExportXml,
+}
+
+// documentOperations are Document methods without parameters changing the document in memory.
+// Save, SaveIncremental and Close are not listed: saving in place must be an explicit SaveAs step.
+var documentOperations = map[string]func(pdf *asposepdf.Document) error{
+	"AddPageNum":            (*asposepdf.Document).AddPageNum,
+	"Decrypt":               (*asposepdf.Document).Decrypt,
+	"EmbedFonts":            (*asposepdf.Document).EmbedFonts,
+	"Flatten":               (*asposepdf.Document).Flatten,
+	"Grayscale":             (*asposepdf.Document).Grayscale,
+	"Optimize":              (*asposepdf.Document).Optimize,
+	"OptimizeResource":      (*asposepdf.Document).OptimizeResource,
+	"PageAdd":               (*asposepdf.Document).PageAdd,
+	"RemoveAnnotations":     (*asposepdf.Document).RemoveAnnotations,
+	"RemoveAttachments":     (*asposepdf.Document).RemoveAttachments,
+	"RemoveBlankPages":      (*asposepdf.Document).RemoveBlankPages,
+	"RemoveBookmarks":       (*asposepdf.Document).RemoveBookmarks,
+	"RemoveHiddenText":      (*asposepdf.Document).RemoveHiddenText,
+	"RemoveImages":          (*asposepdf.Document).RemoveImages,
+	"RemoveJavaScripts":     (*asposepdf.Document).RemoveJavaScripts,
+	"RemovePdfUaCompliance": (*asposepdf.Document).RemovePdfUaCompliance,
+	"RemovePdfaCompliance":  (*asposepdf.Document).RemovePdfaCompliance,
+	"RemoveTables":          (*asposepdf.Document).RemoveTables,
+	"RemoveTextFooters":     (*asposepdf.Document).RemoveTextFooters,
+	"RemoveTextHeaders":     (*asposepdf.Document).RemoveTextHeaders,
+	"RemoveWatermarks":      (*asposepdf.Document).RemoveWatermarks,
+	"Repair":                (*asposepdf.Document).Repair,
+	"UnembedFonts":          (*asposepdf.Document).UnembedFonts,
+}
+
+func init() {
+	for name, save := range saveOperations {
+		operations[name] = saveOperation(save)
+	}
+	for name, apply := range documentOperations {
+		operations[name] = documentOperation(apply)
+	}
+}
+
+// saveOperation returns the operation of a Document method saving with a file name.
+func saveOperation(save func(pdf *asposepdf.Document, filename string) error) operation {
+	return func(p *params) action {
+		p.required("filename")
+		filename := p.string("filename", "")
+		return func(pdf *asposepdf.Document, j *job) error { return save(pdf, j.expand(filename)) }
+	}
+}
+
+// documentOperation returns the operation of a Document method without parameters.
+func documentOperation(apply func(pdf *asposepdf.Document) error) operation {
+	return func(p *params) action {
+		return func(pdf *asposepdf.Document, j *job) error { return apply(pdf) }
+	}
+}
+
+// Operations returns the names of the supported operations in sorted order.
+func Operations() []string {
+	names := make([]string, 0, len(operations))
+	for name := range operations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var rotations = map[string]int64{
+	"0":   int64(asposepdf.RotationNone),
+	"90":  int64(asposepdf.RotationOn90),
+	"180": int64(asposepdf.RotationOn180),
+	"270": int64(asposepdf.RotationOn270),
+}
+
+var pageSizes = map[string]int64{
+	"a0": int64(asposepdf.PageSizeA0), "a1": int64(asposepdf.PageSizeA1), "a2": int64(asposepdf.PageSizeA2),
+	"a3": int64(asposepdf.PageSizeA3), "a4": int64(asposepdf.PageSizeA4), "a5": int64(asposepdf.PageSizeA5),
+	"a6": int64(asposepdf.PageSizeA6), "b5": int64(asposepdf.PageSizeB5),
+	"letter": int64(asposepdf.PageSizePageLetter), "legal": int64(asposepdf.PageSizePageLegal),
+	"ledger": int64(asposepdf.PageSizePageLedger), "11x17": int64(asposepdf.PageSizeP11x17),
+}
+
+var pdfFormats = map[string]int64{
+	"pdf_a_1a": int64(asposepdf.PDF_A_1A), "pdf_a_1b": int64(asposepdf.PDF_A_1B),
+	"pdf_a_2a": int64(asposepdf.PDF_A_2A), "pdf_a_2b": int64(asposepdf.PDF_A_2B), "pdf_a_2u": int64(asposepdf.PDF_A_2U),
+	"pdf_a_3a": int64(asposepdf.PDF_A_3A), "pdf_a_3b": int64(asposepdf.PDF_A_3B), "pdf_a_3u": int64(asposepdf.PDF_A_3U),
+	"pdf_a_4": int64(asposepdf.PDF_A_4), "pdf_a_4e": int64(asposepdf.PDF_A_4E), "pdf_a_4f": int64(asposepdf.PDF_A_4F),
+	"pdf_ua_1":      int64(asposepdf.PDF_UA_1),
+	"pdf_x_1a_2001": int64(asposepdf.PDF_X_1A_2001), "pdf_x_1a": int64(asposepdf.PDF_X_1A),
+	"pdf_x_3": int64(asposepdf.PDF_X_3), "pdf_x_4": int64(asposepdf.PDF_X_4),
+	"pdf_e_1": int64(asposepdf.PDF_E_1),
+	"zugferd": int64(asposepdf.ZUGFeRD),
+	"v_1_0":   int64(asposepdf.V_1_0), "v_1_1": int64(asposepdf.V_1_1), "v_1_2": int64(asposepdf.V_1_2),
+	"v_1_3": int64(asposepdf.V_1_3), "v_1_4": int64(asposepdf.V_1_4), "v_1_5": int64(asposepdf.V_1_5),
+	"v_1_6": int64(asposepdf.V_1_6), "v_1_7": int64(asposepdf.V_1_7), "v_2_0": int64(asposepdf.V_2_0),
+}
+
+var convertErrorActions = map[string]int64{
+	"delete": int64(asposepdf.Delete),
+	"none":   int64(asposepdf.None),
+}
+
+var cryptoAlgorithms = map[string]int64{
+	"rc4_40":  int64(asposepdf.RC4x40),
+	"rc4_128": int64(asposepdf.RC4x128),
+	"aes_128": int64(asposepdf.AESx128),
+	"aes_256": int64(asposepdf.AESx256),
+}
+
+var permissionFlags = map[string]int64{
+	"print":         int64(asposepdf.PrintDocument),
+	"modify":        int64(asposepdf.ModifyContent),
+	"extract":       int64(asposepdf.ExtractContent),
+	"annotate":      int64(asposepdf.ModifyTextAnnotations),
+	"fill_form":     int64(asposepdf.FillForm),
+	"accessibility": int64(asposepdf.ExtractContentWithDisabilities),
+	"assemble":      int64(asposepdf.AssembleDocument),
+	"print_hq":      int64(asposepdf.PrintingQuality),
+}
@@ -0,0 +1,216 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// params reads named parameters of a step and collects validation errors.
+type params struct {
+	values map[string]any
+	used   map[string]bool
+	errs   []error
+}
+
+func newParams(values map[string]any) *params {
+	return &params{values: values, used: map[string]bool{}}
+}
+
+// get returns the value of a parameter and marks it as used.
+func (p *params) get(name string) (any, bool) {
+	p.used[name] = true
+	value, ok := p.values[name]
+	return value, ok && value != nil
+}
+
+func (p *params) fail(name string, format string, args ...any) {
+	p.errs = append(p.errs, fmt.Errorf("parameter %q: %s", name, fmt.Sprintf(format, args...)))
+}
+
+// required reports missing parameters.
+func (p *params) required(names ...string) {
+	for _, name := range names {
+		if _, ok := p.get(name); !ok {
+			p.errs = append(p.errs, fmt.Errorf("parameter %q is required", name))
+		}
+	}
+}
+
+// err returns the collected errors and reports unknown parameters.
+func (p *params) err() error {
+	var unknown []string
+	for name := range p.values {
+		if !p.used[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	errs := p.errs
+	for _, name := range unknown {
+		errs = append(errs, fmt.Errorf("unknown parameter %q", name))
+	}
+	return errors.Join(errs...)
+}
+
+func (p *params) string(name string, def string) string {
+	value, ok := p.get(name)
+	if !ok {
+		return def
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case int, int64, float64, bool:
+		return fmt.Sprint(v)
+	}
+	p.fail(name, "expected a string, got %T", value)
+	return def
+}
+
+func (p *params) integer(name string, def int64) int64 {
+	value, ok := p.get(name)
+	if !ok {
+		return def
+	}
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v)
+		}
+	case float64:
+		if v == math.Trunc(v) {
+			return int64(v)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return i
+		}
+	}
+	p.fail(name, "expected an integer, got %v", value)
+	return def
+}
+
+func (p *params) int32(name string, def int32) int32 {
+	value := p.integer(name, int64(def))
+	if value < math.MinInt32 || value > math.MaxInt32 {
+		p.fail(name, "%d is out of range", value)
+		return def
+	}
+	return int32(value)
+}
+
+func (p *params) float(name string, def float64) float64 {
+	value, ok := p.get(name)
+	if !ok {
+		return def
+	}
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	p.fail(name, "expected a number, got %v", value)
+	return def
+}
+
+func (p *params) boolean(name string, def bool) bool {
+	value, ok := p.get(name)
+	if !ok {
+		return def
+	}
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	p.fail(name, "expected true or false, got %v", value)
+	return def
+}
+
+// enum returns the value of a parameter given by name, case-insensitively.
+func (p *params) enum(name string, values map[string]int64, def int64) int64 {
+	value, ok := p.get(name)
+	if !ok {
+		return def
+	}
+	switch value.(type) {
+	case string, int, int64, json.Number:
+		if v, ok := values[normalizeName(fmt.Sprint(value))]; ok {
+			return v
+		}
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	p.fail(name, "unknown value %v, expected one of %s", value, strings.Join(names, ", "))
+	return def
+}
+
+// flags returns the union of values named in a list or a comma-separated string.
+func (p *params) flags(name string, values map[string]int64, def int64) int64 {
+	value, ok := p.get(name)
+	if !ok {
+		return def
+	}
+	var items []any
+	switch v := value.(type) {
+	case []any:
+		items = v
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	default:
+		p.fail(name, "expected a list, got %v", value)
+		return def
+	}
+	var result int64
+	for _, item := range items {
+		s, _ := item.(string)
+		flag, ok := values[normalizeName(s)]
+		if !ok {
+			p.fail(name, "unknown value %v", item)
+			continue
+		}
+		result |= flag
+	}
+	return result
+}
+
+// normalizeName makes enumeration names case-insensitive and accepts "-", "/" and " " for "_".
+func normalizeName(s string) string {
+	return strings.NewReplacer("-", "_", "/", "_", " ", "_").Replace(strings.ToLower(strings.TrimSpace(s)))
+}
@@ -0,0 +1,149 @@
+// Package pipeline runs declarative processing recipes over PDF-documents.
+//
+// A recipe names a sequence of Document operations with their parameters and is loaded from YAML or JSON:
+//
+//	name: archive
+//	steps:
+//	  - op: Repair
+//	  - op: RemoveJavaScripts
+//	  - op: Flatten
+//	  - op: AddWatermark
+//	    params: {text: CONFIDENTIAL, rotation: 45, opacity: 0.3}
+//	  - op: Convert
+//	    params: {format: PDF_A_2B}
+//	  - op: OptimizeFileSize
+//	    params: {quality: 70}
+//	  - op: SaveAs
+//	    params: {filename: "archive/{name}.pdf"}
+//
+// Operations are Document methods; Operations lists the supported names. Parameters are named,
+// enumerations are given by name, e.g. format: PDF_A_2B or algorithm: aes-256.
+// File name parameters may contain {name} and {dir}, the base name without extension and the directory of the input.
+// Operations of unreleased native functions, e.g. AddHeader or SaveHtml, are added when built with -tags asposepdf_unreleased.
+//
+// A recipe is validated as a whole before any document is processed, and running it produces a Report
+// with the duration and error of every step.
+package pipeline
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Recipe describes a sequence of operations applied to every input document.
+type Recipe struct {
+	Name     string `json:"name" yaml:"name"`         // Name of the recipe shown in reports
+	Password string `json:"password" yaml:"password"` // Password to open encrypted inputs
+	Steps    []Step `json:"steps" yaml:"steps"`       // Operations in order of execution
+}
+
+// Step is a single operation of a recipe.
+type Step struct {
+	Op              string         `json:"op" yaml:"op"`                               // Name of the Document method, e.g. OptimizeFileSize
+	Params          map[string]any `json:"params" yaml:"params"`                       // Named parameters of the operation
+	ContinueOnError bool           `json:"continue_on_error" yaml:"continue_on_error"` // Record the error and run the next step instead of stopping
+}
+
+// Pipeline is a validated recipe ready to run.
+type Pipeline struct {
+	recipe  Recipe
+	actions []action
+}
+
+// Load reads a recipe from a YAML (.yaml, .yml) or JSON (.json) file and validates it.
+//
+// Example:
+//
+//	p, err := pipeline.Load("archive.yaml")
+func Load(filename string) (*Pipeline, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var recipe *Recipe
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		recipe, err = ParseJSON(data)
+	case ".yaml", ".yml":
+		recipe, err = ParseYAML(data)
+	default:
+		return nil, fmt.Errorf("Load(%q): unknown recipe format, expected .yaml, .yml or .json", filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Load(%q): %w", filename, err)
+	}
+	p, err := New(recipe)
+	if err != nil {
+		return nil, fmt.Errorf("Load(%q): %w", filename, err)
+	}
+	return p, nil
+}
+
+// ParseYAML decodes a recipe from YAML. Unknown fields are rejected.
+func ParseYAML(data []byte) (*Recipe, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	recipe := &Recipe{}
+	if err := decoder.Decode(recipe); err != nil {
+		return nil, fmt.Errorf("failed to parse recipe: %w", err)
+	}
+	return recipe, nil
+}
+
+// ParseJSON decodes a recipe from JSON. Unknown fields are rejected.
+func ParseJSON(data []byte) (*Recipe, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+	recipe := &Recipe{}
+	if err := decoder.Decode(recipe); err != nil {
+		return nil, fmt.Errorf("failed to parse recipe: %w", err)
+	}
+	return recipe, nil
+}
+
+// New validates recipe against the available operations and returns a pipeline running it.
+//
+// The returned error lists every invalid step.
+//
+// Example:
+//
+//	p, err := pipeline.New(&pipeline.Recipe{Steps: []pipeline.Step{
+//		{Op: "Optimize"},
+//		{Op: "SaveAs", Params: map[string]any{"filename": "{name}-optimized.pdf"}},
+//	}})
+func New(recipe *Recipe) (*Pipeline, error) {
+	if recipe == nil || len(recipe.Steps) == 0 {
+		return nil, errors.New("recipe has no steps")
+	}
+	p := &Pipeline{recipe: *recipe, actions: make([]action, len(recipe.Steps))}
+	var errs []error
+	for i, step := range recipe.Steps {
+		compile, ok := operations[step.Op]
+		if !ok {
+			errs = append(errs, fmt.Errorf("step %d: unknown operation %q", i+1, step.Op))
+			continue
+		}
+		params := newParams(step.Params)
+		p.actions[i] = compile(params)
+		if err := params.err(); err != nil {
+			errs = append(errs, fmt.Errorf("step %d (%s): %w", i+1, step.Op, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Recipe returns the recipe of the pipeline.
+func (p *Pipeline) Recipe() Recipe {
+	return p.recipe
+}
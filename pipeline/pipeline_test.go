@@ -0,0 +1,174 @@
+package pipeline
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+const testRecipe = `
+name: archive
+steps:
+  - op: Repair
+  - op: RemoveJavaScripts
+  - op: AddWatermark
+    params: {text: CONFIDENTIAL, rotation: 45, opacity: 0.3}
+  - op: AddTextFooter
+    params: {text: "Archived copy"}
+  - op: OptimizeFileSize
+    params: {quality: 70}
+  - op: SaveAs
+    params: {filename: "{dir}/out/{name}.pdf"}
+`
+
+func TestParse(t *testing.T) {
+	recipe, err := ParseYAML([]byte(testRecipe))
+	if err != nil {
+		t.Fatalf("ParseYAML(): %v", err)
+	}
+	if recipe.Name != "archive" || len(recipe.Steps) != 6 {
+		t.Fatalf("ParseYAML() = %+v", recipe)
+	}
+	if _, err := New(recipe); err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+
+	recipe, err = ParseJSON([]byte(`{"steps": [{"op": "Convert", "params": {"format": "pdf/a-2b", "action": "none"}}]}`))
+	if err != nil {
+		t.Fatalf("ParseJSON(): %v", err)
+	}
+	if _, err := New(recipe); err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+
+	if _, err := ParseYAML([]byte("steps:\n  - op: Repair\n    parms: {}\n")); err == nil {
+		t.Error("ParseYAML() with unknown field succeeded, want error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	_, err := New(&Recipe{Steps: []Step{
+		{Op: "Repair"},
+		{Op: "Fly"},
+		{Op: "OptimizeFileSize", Params: map[string]any{"quality": 170}},
+		{Op: "Convert", Params: map[string]any{"format": "PDF_Z"}},
+		{Op: "SaveAs"},
+		{Op: "Flatten", Params: map[string]any{"forms": true}},
+		{Op: "AddTextHeader", Params: map[string]any{"colour": "#000000"}},
+		{Op: "Close"},
+		{Op: "SaveTiff", Params: map[string]any{"filename": "{dir}/{name}.tiff", "dpi": 150}},
+	}})
+	if err == nil {
+		t.Fatal("New() with invalid steps succeeded, want error")
+	}
+	for _, want := range []string{
+		`step 2: unknown operation "Fly"`,
+		`step 3 (OptimizeFileSize): parameter "quality"`,
+		`step 4 (Convert): parameter "format": unknown value PDF_Z`,
+		`step 5 (SaveAs): parameter "filename" is required`,
+		`step 6 (Flatten): unknown parameter "forms"`,
+		`step 7 (AddTextHeader):`,
+		`step 8: unknown operation "Close"`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("New() error %q does not contain %q", err, want)
+		}
+	}
+	for _, valid := range []string{"step 1", "step 9"} {
+		if strings.Contains(err.Error(), valid) {
+			t.Errorf("New() error %q reports valid %s", err, valid)
+		}
+	}
+
+	if _, err := New(&Recipe{}); err == nil {
+		t.Error("New() with no steps succeeded, want error")
+	}
+}
+
+func TestOperations(t *testing.T) {
+	operations := strings.Join(Operations(), ",")
+	for _, op := range []string{"Repair", "Flatten", "RemoveJavaScripts", "AddWatermark", "Convert", "OptimizeFileSize", "SaveAs", "SaveDocX"} {
+		if !strings.Contains(","+operations+",", ","+op+",") {
+			t.Errorf("Operations() does not contain %s", op)
+		}
+	}
+	for _, op := range []string{"Save", "SaveIncremental", "Close"} {
+		if strings.Contains(","+operations+",", ","+op+",") {
+			t.Errorf("Operations() must not contain %s", op)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "sample.pdf")
+	pdf, err := asposepdf.New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	_ = pdf.PageAdd()
+	_ = pdf.PageAddText(1, "Hello pipeline")
+	if err := pdf.SaveAs(input); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+	pdf.Close()
+	if err := os.Mkdir(filepath.Join(dir, "out"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	recipe, err := ParseYAML([]byte(testRecipe))
+	if err != nil {
+		t.Fatalf("ParseYAML(): %v", err)
+	}
+	p, err := New(recipe)
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	report := p.Run(input)
+	if report.Failed() {
+		t.Fatalf("Run() failed: %+v", report)
+	}
+	if report.Recipe != "archive" || report.Input != input || len(report.Steps) != 6 {
+		t.Errorf("Run() = %+v", report)
+	}
+	for _, step := range report.Steps {
+		if step.Skipped || step.Error != "" {
+			t.Errorf("step %s: %+v", step.Op, step)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "out", "sample.pdf")); err != nil {
+		t.Errorf("output: %v", err)
+	}
+
+	// A failed step stops the run unless ContinueOnError is set
+	p, err = New(&Recipe{Steps: []Step{
+		{Op: "PageDelete", Params: map[string]any{"page": 5}, ContinueOnError: true},
+		{Op: "PageDelete", Params: map[string]any{"page": 5}},
+		{Op: "Optimize"},
+	}})
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	report = p.Run(input)
+	if !report.Failed() || report.Error == "" {
+		t.Fatalf("Run() = %+v, want failure", report)
+	}
+	if report.Steps[0].Error == "" || report.Steps[0].Skipped {
+		t.Errorf("step 1 = %+v, want error", report.Steps[0])
+	}
+	if report.Steps[1].Error == "" || !strings.HasPrefix(report.Error, "step 2") {
+		t.Errorf("step 2 = %+v, report error %q", report.Steps[1], report.Error)
+	}
+	if !report.Steps[2].Skipped {
+		t.Errorf("step 3 = %+v, want skipped", report.Steps[2])
+	}
+
+	// Missing input
+	reports := p.RunAll([]string{filepath.Join(dir, "missing.pdf")})
+	if len(reports) != 1 || !reports[0].Failed() || !reports[0].Steps[0].Skipped {
+		t.Errorf("RunAll() = %+v, want failure to open", reports[0])
+	}
+}
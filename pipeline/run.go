@@ -0,0 +1,117 @@
+package pipeline
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+// StepReport contains the result of a single step.
+type StepReport struct {
+	Op       string        `json:"op"`              // Name of the operation
+	Duration time.Duration `json:"duration"`        // Time spent in the operation
+	Skipped  bool          `json:"skipped"`         // Step was not run because an earlier step failed
+	Error    string        `json:"error,omitempty"` // Error message of a failed step
+}
+
+// Report contains the result of running a pipeline over one input document.
+type Report struct {
+	Recipe   string        `json:"recipe"`          // Name of the recipe
+	Input    string        `json:"input"`           // File name of the input document
+	Started  time.Time     `json:"started"`         // Start time of the run
+	Duration time.Duration `json:"duration"`        // Total time including opening and closing the document
+	Steps    []StepReport  `json:"steps"`           // Results of the steps in order
+	Error    string        `json:"error,omitempty"` // Error that stopped the run, empty on success
+}
+
+// Failed returns true if opening the input or any step failed, including steps with ContinueOnError.
+func (report *Report) Failed() bool {
+	if report.Error != "" {
+		return true
+	}
+	for _, step := range report.Steps {
+		if step.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Run opens the input document, applies every step in order and closes the document.
+//
+// A failed step stops the run unless it has ContinueOnError set; the remaining steps are reported as skipped.
+//
+// Example:
+//
+//	report := p.Run("sample.pdf")
+//	if report.Failed() {
+//		log.Println(report.Error)
+//	}
+func (p *Pipeline) Run(input string) *Report {
+	return p.run(input, newBatch())
+}
+
+// run applies the steps to input, sharing b with the other inputs of the run.
+func (p *Pipeline) run(input string, b *batch) *Report {
+	report := &Report{
+		Recipe:  p.recipe.Name,
+		Input:   input,
+		Started: time.Now(),
+		Steps:   make([]StepReport, len(p.recipe.Steps)),
+	}
+	defer func() { report.Duration = time.Since(report.Started) }()
+	for i, step := range p.recipe.Steps {
+		report.Steps[i] = StepReport{Op: step.Op, Skipped: true}
+	}
+
+	var pdf *asposepdf.Document
+	var err error
+	if p.recipe.Password != "" {
+		pdf, err = asposepdf.OpenWithPassword(input, p.recipe.Password)
+	} else {
+		pdf, err = asposepdf.Open(input)
+	}
+	if err != nil {
+		report.Error = fmt.Sprintf("failed to open: %v", err)
+		return report
+	}
+	defer pdf.Close()
+
+	j := &job{input: input, batch: b}
+	for i, step := range p.recipe.Steps {
+		j.step = i
+		started := time.Now()
+		err := p.actions[i](pdf, j)
+		report.Steps[i].Duration = time.Since(started)
+		report.Steps[i].Skipped = false
+		if err != nil {
+			report.Steps[i].Error = err.Error()
+			if !step.ContinueOnError {
+				report.Error = fmt.Sprintf("step %d (%s): %v", i+1, step.Op, err)
+				break
+			}
+		}
+	}
+	return report
+}
+
+// RunAll runs the pipeline over every input in order and returns their reports.
+//
+// Numbering continues across the inputs: Bates numbers of AddHeader and AddFooter steps
+// start after the pages stamped in the previous inputs.
+//
+// Example:
+//
+//	inputs, _ := filepath.Glob("inbox/*.pdf")
+//	for _, report := range p.RunAll(inputs) {
+//		json.NewEncoder(os.Stdout).Encode(report)
+//	}
+func (p *Pipeline) RunAll(inputs []string) []*Report {
+	reports := make([]*Report, 0, len(inputs))
+	b := newBatch()
+	for _, input := range inputs {
+		reports = append(reports, p.run(input, b))
+	}
+	return reports
+}
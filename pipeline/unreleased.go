@@ -0,0 +1,146 @@
+//go:build asposepdf_unreleased
+
+// Operations using the wrappers of unreleased native functions,
+// built with -tags asposepdf_unreleased like the asposepdf package.
+
+package pipeline
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+// unreleasedOperations are added to operations.
+var unreleasedOperations = map[string]operation{
+	"AddWatermarkWithOptions": func(p *params) action {
+		imageFile := p.string("imagefile", "")
+		options := &asposepdf.WatermarkOptions{}
+		p.options(options, "imagefile")
+		return func(pdf *asposepdf.Document, j *job) error {
+			if imageFile != "" {
+				image, err := os.ReadFile(j.expand(imageFile))
+				if err != nil {
+					return err
+				}
+				withImage := *options
+				withImage.Image = image
+				return pdf.AddWatermarkWithOptions(&withImage)
+			}
+			return pdf.AddWatermarkWithOptions(options)
+		}
+	},
+	"AddPageNumbers": func(p *params) action {
+		options := &asposepdf.PageNumberOptions{}
+		p.options(options)
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.AddPageNumbers(options) }
+	},
+	"AddHeader": func(p *params) action {
+		return headerFooter(p, (*asposepdf.Document).AddHeader)
+	},
+	"AddFooter": func(p *params) action {
+		return headerFooter(p, (*asposepdf.Document).AddFooter)
+	},
+	"SaveHtml": func(p *params) action {
+		p.required("filename")
+		filename := p.string("filename", "")
+		options := &asposepdf.HtmlOptions{}
+		p.options(options, "filename")
+		return func(pdf *asposepdf.Document, j *job) error { return pdf.SaveHtml(j.expand(filename), options) }
+	},
+}
+
+func init() {
+	for name, op := range unreleasedOperations {
+		operations[name] = op
+	}
+	operations["SaveAsIncremental"] = saveOperation((*asposepdf.Document).SaveAsIncremental)
+	operations["AutoTag"] = documentOperation((*asposepdf.Document).AutoTag)
+}
+
+// headerFooter reads a HeaderFooter and returns the action stamping it.
+//
+// Bates numbers continue from the pages stamped in the previous inputs of the batch.
+func headerFooter(p *params, stamp func(pdf *asposepdf.Document, template *asposepdf.HeaderFooter) (int32, error)) action {
+	dateFormat := p.string("dateformat", "")
+	template := &asposepdf.HeaderFooter{}
+	p.options(template, "dateformat")
+	template.DateFormat = dateFormat
+	return func(pdf *asposepdf.Document, j *job) error {
+		if template.Bates == nil {
+			_, err := stamp(pdf, template)
+			return err
+		}
+		bates := *template.Bates
+		if next, ok := j.batch.next[j.step]; ok {
+			bates.Start = next
+		}
+		numbered := *template
+		numbered.Bates = &bates
+		stamped, err := stamp(pdf, &numbered)
+		if err != nil {
+			return err
+		}
+		j.batch.next[j.step] = bates.Start + int64(stamped)
+		return nil
+	}
+}
+
+// options decodes all parameters except skip into the JSON fields of target,
+// converting enumeration names of position and style to their values.
+func (p *params) options(target any, skip ...string) {
+	values := map[string]any{}
+	for name := range p.values {
+		if contains(skip, name) {
+			continue
+		}
+		switch name {
+		case "position":
+			values[name] = p.enum(name, positions, 0)
+		case "style":
+			values[name] = p.enum(name, numberingStyles, 0)
+		default:
+			values[name], _ = p.get(name)
+		}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		p.errs = append(p.errs, err)
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		p.errs = append(p.errs, err)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+var positions = map[string]int64{
+	"auto":          int64(asposepdf.PositionAuto),
+	"center":        int64(asposepdf.PositionCenter),
+	"top_left":      int64(asposepdf.PositionTopLeft),
+	"top_center":    int64(asposepdf.PositionTopCenter),
+	"top_right":     int64(asposepdf.PositionTopRight),
+	"bottom_left":   int64(asposepdf.PositionBottomLeft),
+	"bottom_center": int64(asposepdf.PositionBottomCenter),
+	"bottom_right":  int64(asposepdf.PositionBottomRight),
+}
+
+var numberingStyles = map[string]int64{
+	"decimal":       int64(asposepdf.NumberingDecimal),
+	"roman_upper":   int64(asposepdf.NumberingRomanUpper),
+	"roman_lower":   int64(asposepdf.NumberingRomanLower),
+	"letters_upper": int64(asposepdf.NumberingLettersUpper),
+	"letters_lower": int64(asposepdf.NumberingLettersLower),
+}
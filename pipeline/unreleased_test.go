@@ -0,0 +1,83 @@
+//go:build asposepdf_unreleased
+
+package pipeline
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+func TestValidateUnreleased(t *testing.T) {
+	_, err := New(&Recipe{Steps: []Step{
+		{Op: "AddPageNumbers", Params: map[string]any{"format": "Page {page} of {pages}", "position": "bottom-right"}},
+		{Op: "AddPageNumbers", Params: map[string]any{"colour": "#000000"}},
+		{Op: "AddHeader", Params: map[string]any{"left": "{title}", "dateformat": "02.01.2006"}},
+		{Op: "SaveHtml", Params: map[string]any{"filename": "{dir}/{name}.zip", "splitpages": true, "externalimages": true}},
+		{Op: "AddWatermarkWithOptions", Params: map[string]any{"text": "DRAFT", "position": "middle"}},
+	}})
+	if err == nil {
+		t.Fatal("New() with invalid steps succeeded, want error")
+	}
+	for _, want := range []string{`step 2 (AddPageNumbers):`, `step 5 (AddWatermarkWithOptions): parameter "position"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("New() error %q does not contain %q", err, want)
+		}
+	}
+	for _, valid := range []string{"step 1", "step 3", "step 4"} {
+		if strings.Contains(err.Error(), valid) {
+			t.Errorf("New() error %q reports valid %s", err, valid)
+		}
+	}
+}
+
+func TestRunAllBates(t *testing.T) {
+	dir := t.TempDir()
+	inputs := make([]string, 0, 2)
+	for _, name := range []string{"a.pdf", "b.pdf"} {
+		pdf, err := asposepdf.New()
+		if err != nil {
+			t.Fatalf("New(): %v", err)
+		}
+		_ = pdf.PageAdd()
+		_ = pdf.PageAdd()
+		input := filepath.Join(dir, name)
+		if err := pdf.SaveAs(input); err != nil {
+			t.Fatalf("SaveAs(): %v", err)
+		}
+		pdf.Close()
+		inputs = append(inputs, input)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "out"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := New(&Recipe{Steps: []Step{
+		{Op: "AddFooter", Params: map[string]any{"center": "{bates}", "bates": map[string]any{"prefix": "ACME", "start": 1, "digits": 4}}},
+		{Op: "SaveAs", Params: map[string]any{"filename": "{dir}/out/{name}.pdf"}},
+	}})
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	for _, report := range p.RunAll(inputs) {
+		if report.Failed() {
+			t.Fatalf("RunAll() failed: %+v", report)
+		}
+	}
+
+	pdf, err := asposepdf.Open(filepath.Join(dir, "out", "b.pdf"))
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+	defer pdf.Close()
+	text, err := pdf.ExtractText()
+	if err != nil {
+		t.Fatalf("ExtractText(): %v", err)
+	}
+	if !strings.Contains(text, "ACME0003") || !strings.Contains(text, "ACME0004") || strings.Contains(text, "ACME0001") {
+		t.Errorf("second input text %q, want Bates numbers ACME0003 and ACME0004", text)
+	}
+}
@@ -0,0 +1,83 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Recipient is a recipient of a PDF-document encrypted with public-key (certificate) security.
+type Recipient struct {
+	Certificate *x509.Certificate `json:"-"`           // Certificate of the recipient; its public key must be RSA
+	Permissions Permissions       `json:"permissions"` // Permissions granted to the recipient
+}
+
+// MarshalJSON encodes the certificate as DER for the native library.
+func (recipient Recipient) MarshalJSON() ([]byte, error) {
+	var certificate []byte
+	if recipient.Certificate != nil {
+		certificate = recipient.Certificate.Raw
+	}
+	type plainRecipient Recipient
+	return json.Marshal(struct {
+		plainRecipient
+		Certificate []byte `json:"certificate"`
+	}{plainRecipient(recipient), certificate})
+}
+
+// OpenWithCertificate opens a PDF-document encrypted with public-key (certificate) security.
+//
+// The certificate identifies the recipient, the private key decrypts the document key.
+//
+// Example:
+//
+//	pdf, err := OpenWithCertificate("example.pdf", cert, key)
+//	if err != nil {
+//		fmt.Errorf("OpenWithCertificate(): %v", err)
+//	} else {
+//		// working with open PDF-document
+//	}
+func OpenWithCertificate(filename string, cert *x509.Certificate, key crypto.PrivateKey) (*Document, error) {
+	if cert == nil || key == nil {
+		return &Document{nil}, errors.New("OpenWithCertificate(): certificate and private key are required")
+	}
+	if len(cert.Raw) == 0 {
+		return &Document{nil}, errors.New("OpenWithCertificate(): certificate is not parsed, Raw is empty")
+	}
+	keyData, e := x509.MarshalPKCS8PrivateKey(key)
+	if e != nil {
+		return &Document{nil}, fmt.Errorf("OpenWithCertificate(): %w", e)
+	}
+	return openWithCertificate(filename, cert.Raw, keyData)
+}
+
+// EncryptForRecipients encrypts PDF-document with public-key (certificate) security.
+//
+// Each recipient opens the document with OpenWithCertificate and gets its own permissions.
+//
+// Example:
+//
+//	err := pdf.EncryptForRecipients([]Recipient{{Certificate: cert, Permissions: PrintDocument}}, AESx256)
+func (document *Document) EncryptForRecipients(recipients []Recipient, cryptoAlgorithm CryptoAlgorithm) error {
+	if len(recipients) == 0 {
+		return errors.New("EncryptForRecipients(): no recipients")
+	}
+	for i, recipient := range recipients {
+		if recipient.Certificate == nil {
+			return fmt.Errorf("EncryptForRecipients(): recipient at index %d has no certificate", i)
+		}
+		if _, ok := recipient.Certificate.PublicKey.(*rsa.PublicKey); !ok {
+			return fmt.Errorf("EncryptForRecipients(): recipient at index %d: unsupported public key type %T", i, recipient.Certificate.PublicKey)
+		}
+	}
+	recipients_json, e := json.Marshal(recipients)
+	if e != nil {
+		return e
+	}
+	return document.encryptForRecipients(string(recipients_json), cryptoAlgorithm)
+}
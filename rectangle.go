@@ -0,0 +1,11 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+// Rectangle represents a rectangular area of a page.
+type Rectangle struct {
+	X      int32 `json:"x"`      // X indent of the lower left corner
+	Y      int32 `json:"y"`      // Y indent of the lower left corner
+	Width  int32 `json:"width"`  // Width
+	Height int32 `json:"height"` // Height
+}
@@ -0,0 +1,54 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+// RevisionInfo contains information about a revision (incremental update) of a PDF-document.
+type RevisionInfo struct {
+	Number     int32    `json:"number"`     // Revision number, starting from 1
+	Size       int64    `json:"size"`       // Length in bytes of the file up to the end of this revision
+	Signatures []string `json:"signatures"` // Names of signature fields signed in this revision
+}
+
+// SaveIncremental saves previously opened PDF-document as an incremental update.
+//
+// Changes are appended to the end of the file as a new revision, so existing digital signatures stay valid.
+//
+// Example:
+//
+//	err := pdf.SaveIncremental()
+func (document *Document) SaveIncremental() error {
+	return document.saveIncremental()
+}
+
+// SaveAsIncremental saves previously opened PDF-document as an incremental update with new filename.
+//
+// The original file content is copied unchanged and changes are appended as a new revision.
+//
+// Example:
+//
+//	err := pdf.SaveAsIncremental("approved.pdf")
+func (document *Document) SaveAsIncremental(filename string) error {
+	return document.saveAsIncremental(filename)
+}
+
+// Revisions returns the list of revisions (incremental updates) of PDF-document.
+//
+// The first revision is the original document, the last one is the latest saved state.
+//
+// See also: revision_info.go
+//
+// Example:
+//
+//	revisions, err := pdf.Revisions()
+func (document *Document) Revisions() ([]RevisionInfo, error) {
+	return document.revisions()
+}
+
+// ExtractRevision saves the specified revision of PDF-document as a separate PDF-document with filename.
+//
+// Example:
+//
+//	err := pdf.ExtractRevision(1, "original.pdf")
+func (document *Document) ExtractRevision(num int32, filename string) error {
+	return document.extractRevision(num, filename)
+}
@@ -0,0 +1,138 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// RevocationClient obtains revocation data for a certificate, used by SignPAdES for the DSS dictionary.
+//
+// Implementations return DER-encoded CRLs and OCSP responses covering cert, issued by issuer.
+type RevocationClient interface {
+	Revocation(cert, issuer *x509.Certificate) (crls [][]byte, ocspResponses [][]byte, err error)
+}
+
+// HTTPRevocationClient fetches an OCSP response from the OCSP responder of a certificate,
+// or its CRL from the CRL distribution point if the certificate has no OCSP responder or it fails.
+type HTTPRevocationClient struct {
+	Client *http.Client // HTTP client; a client with a 30 seconds timeout if nil
+}
+
+// Maximum size of a CRL or an OCSP response.
+const maxRevocationResponseSize = 10 << 20
+
+type ocspRequest struct {
+	TBSRequest ocspTBSRequest
+}
+
+type ocspTBSRequest struct {
+	RequestList []ocspSingleRequest
+}
+
+type ocspSingleRequest struct {
+	CertID ocspCertID
+}
+
+// Revocation returns an OCSP response or a CRL for cert, verified to be signed by issuer.
+func (client *HTTPRevocationClient) Revocation(cert, issuer *x509.Certificate) ([][]byte, [][]byte, error) {
+	var errs []error
+	for _, url := range cert.OCSPServer {
+		response, err := client.ocsp(url, cert, issuer)
+		if err == nil {
+			return nil, [][]byte{response}, nil
+		}
+		errs = append(errs, err)
+	}
+	for _, url := range cert.CRLDistributionPoints {
+		crl, err := client.crl(url, issuer)
+		if err == nil {
+			return [][]byte{crl}, nil, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil, nil, fmt.Errorf("certificate %s has no OCSP responder or CRL distribution point", cert.Subject)
+	}
+	return nil, nil, fmt.Errorf("failed to fetch revocation data for %s: %w", cert.Subject, errors.Join(errs...))
+}
+
+// ocsp requests the status of cert from the OCSP responder at url.
+func (client *HTTPRevocationClient) ocsp(url string, cert, issuer *x509.Certificate) ([]byte, error) {
+	keyHash, err := issuerKeyHash(issuer, crypto.SHA1)
+	if err != nil {
+		return nil, err
+	}
+	nameHash := sha1.Sum(issuer.RawSubject)
+	request, err := asn1.Marshal(ocspRequest{ocspTBSRequest{[]ocspSingleRequest{{ocspCertID{
+		HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}, Parameters: asn1.NullRawValue},
+		NameHash:      nameHash[:],
+		IssuerKeyHash: keyHash,
+		SerialNumber:  cert.SerialNumber,
+	}}}}})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.fetch(http.MethodPost, url, "application/ocsp-request", request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := parseOCSPResponse(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OCSP response from %s: %w", url, err)
+	}
+	if _, ok := response.status(cert, issuer); !ok {
+		return nil, fmt.Errorf("OCSP response from %s does not cover the certificate", url)
+	}
+	return body, nil
+}
+
+// crl downloads the CRL at url.
+func (client *HTTPRevocationClient) crl(url string, issuer *x509.Certificate) ([]byte, error) {
+	body, err := client.fetch(http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	crl, err := x509.ParseRevocationList(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CRL from %s: %w", url, err)
+	}
+	if err := crl.CheckSignatureFrom(issuer); err != nil {
+		return nil, fmt.Errorf("CRL from %s is not signed by the issuer: %w", url, err)
+	}
+	return body, nil
+}
+
+// fetch sends an HTTP request with an optional body and returns the response body.
+func (client *HTTPRevocationClient) fetch(method string, url string, contentType string, body []byte) ([]byte, error) {
+	httpRequest, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		httpRequest.Header.Set("Content-Type", contentType)
+	}
+	httpClient := client.Client
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s responded with %s", url, httpResponse.Status)
+	}
+	return io.ReadAll(io.LimitReader(httpResponse.Body, maxRevocationResponseSize))
+}
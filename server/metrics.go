@@ -0,0 +1,110 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// durationBuckets are the upper bounds of the request duration histogram in seconds.
+var durationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120}
+
+// histogram counts observations by bucket.
+type histogram struct {
+	counts []uint64 // Observations per bucket, not cumulative
+	sum    float64
+	count  uint64
+}
+
+// metrics collects request statistics exposed in the Prometheus text format.
+type metrics struct {
+	mu        sync.Mutex
+	requests  map[string]map[int]uint64 // Requests by endpoint and status code
+	durations map[string]*histogram     // Request durations by endpoint
+}
+
+func newMetrics() *metrics {
+	return &metrics{requests: map[string]map[int]uint64{}, durations: map[string]*histogram{}}
+}
+
+// observe records a request of endpoint.
+func (m *metrics) observe(endpoint string, code int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.requests[endpoint] == nil {
+		m.requests[endpoint] = map[int]uint64{}
+	}
+	m.requests[endpoint][code]++
+	h := m.durations[endpoint]
+	if h == nil {
+		h = &histogram{counts: make([]uint64, len(durationBuckets))}
+		m.durations[endpoint] = h
+	}
+	seconds := duration.Seconds()
+	for i, bound := range durationBuckets {
+		if seconds <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+// write writes the metrics in the Prometheus text exposition format.
+func (m *metrics) write(w io.Writer, p *pool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	endpoints := make([]string, 0, len(m.requests))
+	for endpoint := range m.requests {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	fmt.Fprintln(w, "# HELP asposepdf_requests_total Requests by endpoint and status code.")
+	fmt.Fprintln(w, "# TYPE asposepdf_requests_total counter")
+	for _, endpoint := range endpoints {
+		codes := make([]int, 0, len(m.requests[endpoint]))
+		for code := range m.requests[endpoint] {
+			codes = append(codes, code)
+		}
+		sort.Ints(codes)
+		for _, code := range codes {
+			fmt.Fprintf(w, "asposepdf_requests_total{endpoint=%q,code=\"%d\"} %d\n", endpoint, code, m.requests[endpoint][code])
+		}
+	}
+
+	fmt.Fprintln(w, "# HELP asposepdf_request_duration_seconds Request durations by endpoint.")
+	fmt.Fprintln(w, "# TYPE asposepdf_request_duration_seconds histogram")
+	for _, endpoint := range endpoints {
+		h := m.durations[endpoint]
+		var cumulative uint64
+		for i, bound := range durationBuckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(w, "asposepdf_request_duration_seconds_bucket{endpoint=%q,le=%q} %d\n", endpoint, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(w, "asposepdf_request_duration_seconds_bucket{endpoint=%q,le=\"+Inf\"} %d\n", endpoint, h.count)
+		fmt.Fprintf(w, "asposepdf_request_duration_seconds_sum{endpoint=%q} %g\n", endpoint, h.sum)
+		fmt.Fprintf(w, "asposepdf_request_duration_seconds_count{endpoint=%q} %d\n", endpoint, h.count)
+	}
+
+	fmt.Fprintln(w, "# HELP asposepdf_workers Number of workers.")
+	fmt.Fprintln(w, "# TYPE asposepdf_workers gauge")
+	fmt.Fprintf(w, "asposepdf_workers %d\n", p.workers)
+	fmt.Fprintln(w, "# HELP asposepdf_workers_busy Workers processing a request.")
+	fmt.Fprintln(w, "# TYPE asposepdf_workers_busy gauge")
+	fmt.Fprintf(w, "asposepdf_workers_busy %d\n", p.busy.Load())
+	fmt.Fprintln(w, "# HELP asposepdf_requests_waiting Requests waiting for a worker.")
+	fmt.Fprintln(w, "# TYPE asposepdf_requests_waiting gauge")
+	fmt.Fprintf(w, "asposepdf_requests_waiting %d\n", p.waiting.Load())
+}
+
+func (s *Server) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	s.metrics.write(w, s.pool)
+}
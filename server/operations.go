@@ -0,0 +1,380 @@
+package server
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+const pdfContentType = "application/pdf"
+
+// conversion is a target format of /convert.
+type conversion struct {
+	ext         string
+	contentType string
+	save        func(pdf *asposepdf.Document, filename string) error
+}
+
+var conversions = map[string]conversion{
+	"docx":          {".docx", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", (*asposepdf.Document).SaveDocX},
+	"docx-enhanced": {".docx", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", (*asposepdf.Document).SaveDocXEnhanced},
+	"doc":           {".doc", "application/msword", (*asposepdf.Document).SaveDoc},
+	"xlsx":          {".xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", (*asposepdf.Document).SaveXlsX},
+	"pptx":          {".pptx", "application/vnd.openxmlformats-officedocument.presentationml.presentation", (*asposepdf.Document).SavePptX},
+	"xps":           {".xps", "application/vnd.ms-xpsdocument", (*asposepdf.Document).SaveXps},
+	"txt":           {".txt", "text/plain; charset=utf-8", (*asposepdf.Document).SaveTxt},
+	"epub":          {".epub", "application/epub+zip", (*asposepdf.Document).SaveEpub},
+	"tex":           {".tex", "application/x-tex", (*asposepdf.Document).SaveTeX},
+	"md":            {".md", "text/markdown; charset=utf-8", (*asposepdf.Document).SaveMarkdown},
+	"svgzip":        {".zip", "application/zip", (*asposepdf.Document).SaveSvgZip},
+	"booklet":       {".pdf", pdfContentType, (*asposepdf.Document).SaveBooklet},
+	"tiff": {".tiff", "image/tiff", func(pdf *asposepdf.Document, filename string) error {
+		return pdf.SaveTiff(filename)
+	}},
+}
+
+// rendering is an image format of /render.
+type rendering struct {
+	ext         string
+	contentType string
+	render      func(pdf *asposepdf.Document, num int32, dpi int32, filename string) error
+}
+
+var renderings = map[string]rendering{
+	"png":  {".png", "image/png", (*asposepdf.Document).PageToPng},
+	"jpg":  {".jpg", "image/jpeg", (*asposepdf.Document).PageToJpg},
+	"bmp":  {".bmp", "image/bmp", (*asposepdf.Document).PageToBmp},
+	"tiff": {".tiff", "image/tiff", (*asposepdf.Document).PageToTiff},
+	"svg": {".svg", "image/svg+xml", func(pdf *asposepdf.Document, num int32, dpi int32, filename string) error {
+		return pdf.PageToSvg(num, filename)
+	}},
+}
+
+var pdfFormats = map[string]asposepdf.PdfFormat{
+	"pdf_a_1a": asposepdf.PDF_A_1A, "pdf_a_1b": asposepdf.PDF_A_1B,
+	"pdf_a_2a": asposepdf.PDF_A_2A, "pdf_a_2b": asposepdf.PDF_A_2B, "pdf_a_2u": asposepdf.PDF_A_2U,
+	"pdf_a_3a": asposepdf.PDF_A_3A, "pdf_a_3b": asposepdf.PDF_A_3B, "pdf_a_3u": asposepdf.PDF_A_3U,
+	"pdf_a_4": asposepdf.PDF_A_4, "pdf_a_4e": asposepdf.PDF_A_4E, "pdf_a_4f": asposepdf.PDF_A_4F,
+	"pdf_ua_1":      asposepdf.PDF_UA_1,
+	"pdf_x_1a_2001": asposepdf.PDF_X_1A_2001, "pdf_x_1a": asposepdf.PDF_X_1A,
+	"pdf_x_3": asposepdf.PDF_X_3, "pdf_x_4": asposepdf.PDF_X_4,
+	"pdf_e_1": asposepdf.PDF_E_1,
+	"zugferd": asposepdf.ZUGFeRD,
+}
+
+// pdfFormat returns the PdfFormat named case-insensitively, with "-" for "_" allowed.
+func pdfFormat(name string) (asposepdf.PdfFormat, bool) {
+	format, ok := pdfFormats[strings.ReplaceAll(strings.ToLower(name), "-", "_")]
+	return format, ok
+}
+
+// open opens an uploaded file with the password parameter of the request.
+func (req *request) open(u upload) (*asposepdf.Document, error) {
+	if password := req.values.Get("password"); password != "" {
+		return asposepdf.OpenWithPassword(u.path, password)
+	}
+	return asposepdf.Open(u.path)
+}
+
+// process opens the single uploaded file, applies fn and returns the document saved as PDF.
+func process(req *request, suffix string, fn func(pdf *asposepdf.Document) error) (*result, error) {
+	u, err := req.file()
+	if err != nil {
+		return nil, err
+	}
+	pdf, err := req.open(u)
+	if err != nil {
+		return nil, err
+	}
+	defer pdf.Close()
+	if err := fn(pdf); err != nil {
+		return nil, err
+	}
+	output := req.output("result.pdf")
+	if err := pdf.SaveAs(output); err != nil {
+		return nil, err
+	}
+	return &result{contentType: pdfContentType, filename: output, download: u.base() + suffix + ".pdf"}, nil
+}
+
+func convert(req *request) (*result, error) {
+	if format, ok := pdfFormat(req.format); ok {
+		return process(req, "", func(pdf *asposepdf.Document) error {
+			ok, log, err := pdf.Convert(format, asposepdf.Delete)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("conversion to %s failed: %s", req.format, log)
+			}
+			return nil
+		})
+	}
+	c, ok := conversions[strings.ToLower(req.format)]
+	if !ok {
+		return nil, badRequest("unknown target format %q", req.format)
+	}
+	u, err := req.file()
+	if err != nil {
+		return nil, err
+	}
+	pdf, err := req.open(u)
+	if err != nil {
+		return nil, err
+	}
+	defer pdf.Close()
+	output := req.output("result" + c.ext)
+	if err := c.save(pdf, output); err != nil {
+		return nil, err
+	}
+	return &result{contentType: c.contentType, filename: output, download: u.base() + c.ext}, nil
+}
+
+func render(req *request) (*result, error) {
+	r, ok := renderings[strings.ToLower(req.format)]
+	if !ok {
+		return nil, badRequest("unknown image format %q", req.format)
+	}
+	page, err := req.int("page", 1)
+	if err != nil {
+		return nil, err
+	}
+	dpi, err := req.int("dpi", 150)
+	if err != nil {
+		return nil, err
+	}
+	if dpi < 1 || dpi > 1200 {
+		return nil, badRequest("parameter %q must be from 1 to 1200", "dpi")
+	}
+	u, err := req.file()
+	if err != nil {
+		return nil, err
+	}
+	pdf, err := req.open(u)
+	if err != nil {
+		return nil, err
+	}
+	defer pdf.Close()
+	count, err := pdf.PageCount()
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 || page > int(count) {
+		return nil, badRequest("page %d is out of range 1-%d", page, count)
+	}
+	output := req.output("page" + r.ext)
+	if err := r.render(pdf, int32(page), int32(dpi), output); err != nil {
+		return nil, err
+	}
+	return &result{contentType: r.contentType, filename: output, download: fmt.Sprintf("%s-%d%s", u.base(), page, r.ext)}, nil
+}
+
+func merge(req *request) (*result, error) {
+	if len(req.files) == 0 {
+		return nil, badRequest(`at least one "file" part expected`)
+	}
+	documents := make([]*asposepdf.Document, 0, len(req.files))
+	defer func() {
+		for _, pdf := range documents {
+			pdf.Close()
+		}
+	}()
+	for _, u := range req.files {
+		pdf, err := req.open(u)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, pdf)
+	}
+	merged, err := asposepdf.MergeDocuments(documents)
+	if err != nil {
+		return nil, err
+	}
+	defer merged.Close()
+	output := req.output("merged.pdf")
+	if err := merged.SaveAs(output); err != nil {
+		return nil, err
+	}
+	return &result{contentType: pdfContentType, filename: output, download: "merged.pdf"}, nil
+}
+
+func split(req *request) (*result, error) {
+	ranges := req.values["pages"]
+	if len(ranges) == 0 {
+		return nil, badRequest("parameter %q is required", "pages")
+	}
+	u, err := req.file()
+	if err != nil {
+		return nil, err
+	}
+	pdf, err := req.open(u)
+	if err != nil {
+		return nil, err
+	}
+	defer pdf.Close()
+	parts, err := pdf.Split(strings.Join(ranges, ";"))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, part := range parts {
+			part.Close()
+		}
+	}()
+
+	output := req.output("parts.zip")
+	file, err := os.Create(output)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	archive := zip.NewWriter(file)
+	for i, part := range parts {
+		partname := req.output(fmt.Sprintf("part-%d.pdf", i+1))
+		if err := part.SaveAs(partname); err != nil {
+			return nil, fmt.Errorf("part %d: %w", i+1, err)
+		}
+		if err := addToZip(archive, fmt.Sprintf("%s-%d.pdf", u.base(), i+1), partname); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return &result{contentType: "application/zip", filename: output, download: u.base() + ".zip"}, nil
+}
+
+// addToZip copies the file at path into archive as name.
+func addToZip(archive *zip.Writer, name, path string) error {
+	w, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(w, file)
+	return err
+}
+
+func optimize(req *request) (*result, error) {
+	resources, err := req.bool("resources")
+	if err != nil {
+		return nil, err
+	}
+	quality, err := req.int("quality", 0)
+	if err != nil {
+		return nil, err
+	}
+	if quality < 0 || quality > 100 {
+		return nil, badRequest("parameter %q must be from 0 to 100", "quality")
+	}
+	return process(req, "-optimized", func(pdf *asposepdf.Document) error {
+		if err := pdf.Optimize(); err != nil {
+			return err
+		}
+		if resources {
+			if err := pdf.OptimizeResource(); err != nil {
+				return err
+			}
+		}
+		if quality > 0 {
+			return pdf.OptimizeFileSize(int32(quality))
+		}
+		return nil
+	})
+}
+
+func validate(req *request) (*result, error) {
+	name, err := req.required("format")
+	if err != nil {
+		return nil, err
+	}
+	format, ok := pdfFormat(name)
+	if !ok {
+		return nil, badRequest("unknown PDF format %q", name)
+	}
+	u, err := req.file()
+	if err != nil {
+		return nil, err
+	}
+	pdf, err := req.open(u)
+	if err != nil {
+		return nil, err
+	}
+	defer pdf.Close()
+	report, err := pdf.ValidateWithReport(format)
+	if err != nil {
+		return nil, err
+	}
+	return jsonResult(struct {
+		Format     string                      `json:"format"`
+		Valid      bool                        `json:"valid"`
+		Issues     []asposepdf.ValidationIssue `json:"issues"`
+		Errors     int                         `json:"errors"`
+		Warnings   int                         `json:"warnings"`
+		Categories map[string]int              `json:"categories"`
+		Log        string                      `json:"log"`
+	}{name, report.Valid, report.Issues, report.Errors, report.Warnings, report.Categories, report.Log})
+}
+
+func sign(req *request) (*result, error) {
+	certificate, ok := req.parts["certificate"]
+	if !ok {
+		return nil, badRequest(`"certificate" part with a PKCS#12 file expected`)
+	}
+	signData, err := os.ReadFile(certificate.path)
+	if err != nil {
+		return nil, err
+	}
+	page, err := req.int("page", 1)
+	if err != nil {
+		return nil, err
+	}
+	u, err := req.file()
+	if err != nil {
+		return nil, err
+	}
+	pdf, err := req.open(u)
+	if err != nil {
+		return nil, err
+	}
+	defer pdf.Close()
+	count, err := pdf.PageCount()
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 || page > int(count) {
+		return nil, badRequest("page %d is out of range 1-%d", page, count)
+	}
+	output := req.output("signed.pdf")
+	err = pdf.SignPKCS7(int32(page), signData, req.values.Get("certificate_password"), 0, 0, 0, 0,
+		req.values.Get("reason"), req.values.Get("contact"), req.values.Get("location"), false, nil, output)
+	if err != nil {
+		return nil, err
+	}
+	return &result{contentType: pdfContentType, filename: output, download: u.base() + "-signed.pdf"}, nil
+}
+
+func text(req *request) (*result, error) {
+	u, err := req.file()
+	if err != nil {
+		return nil, err
+	}
+	pdf, err := req.open(u)
+	if err != nil {
+		return nil, err
+	}
+	defer pdf.Close()
+	txt, err := pdf.ExtractText()
+	if err != nil {
+		return nil, err
+	}
+	return &result{contentType: "text/plain; charset=utf-8", body: []byte(txt)}, nil
+}
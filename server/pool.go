@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Errors of pool.run.
+var (
+	errBusy   = errors.New("no worker available before the request timeout")
+	errClosed = errors.New("server is closed")
+)
+
+// pool is a bounded set of workers, each locked to its own OS thread.
+//
+// The native library requires a document to be used on the thread that opened it,
+// so a job opens, processes and closes its documents on the same worker.
+type pool struct {
+	workers int
+	jobs    chan func()
+	quit    chan struct{}
+	once    sync.Once
+	wg      sync.WaitGroup
+	busy    atomic.Int64 // Workers running a job
+	waiting atomic.Int64 // Requests waiting for a worker
+}
+
+func newPool(workers int) *pool {
+	p := &pool{workers: workers, jobs: make(chan func()), quit: make(chan struct{})}
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+func (p *pool) work() {
+	defer p.wg.Done()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	for {
+		select {
+		case job := <-p.jobs:
+			p.busy.Add(1)
+			job()
+			p.busy.Add(-1)
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// run passes job to a free worker and returns a channel closed when job returns.
+//
+// It fails with errBusy if ctx is done before a worker is free, or with errClosed after close.
+func (p *pool) run(ctx context.Context, job func()) (<-chan struct{}, error) {
+	done := make(chan struct{})
+	p.waiting.Add(1)
+	defer p.waiting.Add(-1)
+	select {
+	case p.jobs <- func() { defer close(done); job() }:
+		return done, nil
+	case <-ctx.Done():
+		return nil, errBusy
+	case <-p.quit:
+		return nil, errClosed
+	}
+}
+
+// close stops the workers after their running jobs return.
+func (p *pool) close() {
+	p.once.Do(func() { close(p.quit) })
+	p.wg.Wait()
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// maxFieldSize is the maximum size of a form field value.
+const maxFieldSize = 64 << 10
+
+// upload is an uploaded file of a request.
+type upload struct {
+	name string // File name sent by the client
+	path string // Path of the stored file
+}
+
+// base returns the uploaded file name without directory and extension.
+func (u upload) base() string {
+	name := filepath.Base(strings.ReplaceAll(u.name, `\`, "/"))
+	if name = strings.TrimSuffix(name, filepath.Ext(name)); name == "" || name == "." || name == "/" {
+		return "document"
+	}
+	return name
+}
+
+// request contains the uploaded files and parameters of a request stored in a temporary directory.
+type request struct {
+	dir    string            // Temporary directory for uploaded and generated files
+	files  []upload          // Parts named "file" in order
+	parts  map[string]upload // Other file parts by field name
+	values url.Values        // Query parameters and form fields
+	format string            // Path parameter {format}
+}
+
+// readRequest stores the multipart body of r in a new temporary directory in tempDir.
+func readRequest(r *http.Request, tempDir string) (*request, error) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		return nil, &statusError{http.StatusUnsupportedMediaType, errors.New("multipart/form-data request expected")}
+	}
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, badRequest("%v", err)
+	}
+	dir, err := os.MkdirTemp(tempDir, "asposepdf-server-*")
+	if err != nil {
+		return nil, err
+	}
+	req := &request{
+		dir:    dir,
+		parts:  map[string]upload{},
+		values: r.URL.Query(),
+		format: r.PathValue("format"),
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			req.cleanup()
+			return nil, wrapReadError(err)
+		}
+		if err := req.readPart(part.FormName(), part.FileName(), part); err != nil {
+			part.Close()
+			req.cleanup()
+			return nil, err
+		}
+		part.Close()
+	}
+	return req, nil
+}
+
+// readPart stores a file part or adds a form field to the values.
+func (req *request) readPart(field, filename string, r io.Reader) error {
+	if field == "" {
+		return nil
+	}
+	if filename == "" {
+		value, err := io.ReadAll(io.LimitReader(r, maxFieldSize+1))
+		if err != nil {
+			return wrapReadError(err)
+		}
+		if len(value) > maxFieldSize {
+			return badRequest("form field %q exceeds %d bytes", field, maxFieldSize)
+		}
+		req.values.Add(field, string(value))
+		return nil
+	}
+	file, err := os.CreateTemp(req.dir, "upload-*")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := io.Copy(file, r); err != nil {
+		return wrapReadError(err)
+	}
+	u := upload{name: filename, path: file.Name()}
+	if field == "file" {
+		req.files = append(req.files, u)
+	} else {
+		req.parts[field] = u
+	}
+	return nil
+}
+
+// wrapReadError keeps http.MaxBytesError and reports other read errors as bad requests.
+func wrapReadError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return err
+	}
+	return badRequest("failed to read request: %v", err)
+}
+
+// cleanup removes the temporary directory of the request.
+func (req *request) cleanup() {
+	os.RemoveAll(req.dir)
+}
+
+// file returns the single uploaded "file" part.
+func (req *request) file() (upload, error) {
+	if len(req.files) != 1 {
+		return upload{}, badRequest(`exactly one "file" part expected, got %d`, len(req.files))
+	}
+	return req.files[0], nil
+}
+
+// output returns a path for a generated file in the temporary directory.
+func (req *request) output(name string) string {
+	return filepath.Join(req.dir, "out-"+name)
+}
+
+// int returns an integer parameter, def if it is empty.
+func (req *request) int(name string, def int) (int, error) {
+	value := req.values.Get(name)
+	if value == "" {
+		return def, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, badRequest("parameter %q: %q is not an integer", name, value)
+	}
+	return i, nil
+}
+
+// bool returns a boolean parameter, false if it is empty.
+func (req *request) bool(name string) (bool, error) {
+	value := req.values.Get(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, badRequest("parameter %q: %q is not a boolean", name, value)
+	}
+	return b, nil
+}
+
+// required returns a parameter that must not be empty.
+func (req *request) required(name string) (string, error) {
+	value := req.values.Get(name)
+	if value == "" {
+		return "", badRequest("parameter %q is required", name)
+	}
+	return value, nil
+}
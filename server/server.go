@@ -0,0 +1,253 @@
+// Package server exposes document operations as an HTTP service.
+//
+// Endpoints accept multipart/form-data requests with the input PDF-documents in "file" parts,
+// parameters are read from the query string and from form fields:
+//
+//	POST /convert/{format}  convert to docx, docx-enhanced, doc, xlsx, pptx, xps, txt, epub, tex, md, svgzip, booklet, tiff or a PDF standard like pdf_a_2b;
+//	                        html and htmlzip when built with -tags asposepdf_unreleased
+//	POST /render/{format}   render a page to png, jpg, bmp, tiff or svg; page (1 if empty), dpi (150 if empty)
+//	POST /merge             merge all "file" parts in order
+//	POST /split             split by page ranges, one pages parameter per part; responds with a ZIP archive
+//	POST /optimize          optimize; resources=true removes unused resources, quality=1..100 compresses images, 0 does not
+//	POST /validate          validate compliance with format, e.g. pdf_a_2b; responds with JSON {"valid", "issues", "errors", "warnings", "categories", "log"}
+//	POST /sign              sign with a PKCS#12 "certificate" part; certificate_password, reason, location, contact, page (1 if empty)
+//	POST /text              extract plain text
+//	GET  /metrics           metrics in the Prometheus text format
+//	GET  /healthz           liveness check
+//
+// The password parameter opens encrypted inputs. Errors are returned as JSON {"error": "..."}.
+//
+// Documents are processed by a bounded pool of workers, each locked to its OS thread for the lifetime
+// of the documents it handles. A request waits for a free worker up to the request timeout.
+// Results are written to temporary files and streamed to the client.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"runtime"
+	"time"
+)
+
+// Config contains settings of a Server.
+type Config struct {
+	Workers        int           // Number of requests processed concurrently, runtime.NumCPU() if zero
+	MaxRequestSize int64         // Maximum size of a request body in bytes, 64 MiB if zero
+	Timeout        time.Duration // Maximum time to wait for a worker and process a request, 2 minutes if zero
+	TempDir        string        // Directory for uploaded and generated files, os.TempDir() if empty
+	ErrorLog       *log.Logger   // Logger of failed requests, the log package's standard logger if nil
+}
+
+// Server is an http.Handler serving document operations.
+type Server struct {
+	config  Config
+	mux     *http.ServeMux
+	pool    *pool
+	metrics *metrics
+}
+
+// New returns a Server with the given configuration and starts its workers.
+//
+// Example:
+//
+//	srv := server.New(server.Config{Workers: 4, Timeout: time.Minute})
+//	defer srv.Close()
+//	log.Fatal(http.ListenAndServe(":8080", srv))
+func New(config Config) *Server {
+	if config.Workers <= 0 {
+		config.Workers = runtime.NumCPU()
+	}
+	if config.MaxRequestSize <= 0 {
+		config.MaxRequestSize = 64 << 20
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 2 * time.Minute
+	}
+	if config.ErrorLog == nil {
+		config.ErrorLog = log.Default()
+	}
+	s := &Server{
+		config:  config,
+		mux:     http.NewServeMux(),
+		pool:    newPool(config.Workers),
+		metrics: newMetrics(),
+	}
+	s.mux.Handle("POST /convert/{format}", s.handle("convert", convert))
+	s.mux.Handle("POST /render/{format}", s.handle("render", render))
+	s.mux.Handle("POST /merge", s.handle("merge", merge))
+	s.mux.Handle("POST /split", s.handle("split", split))
+	s.mux.Handle("POST /optimize", s.handle("optimize", optimize))
+	s.mux.Handle("POST /validate", s.handle("validate", validate))
+	s.mux.Handle("POST /sign", s.handle("sign", sign))
+	s.mux.Handle("POST /text", s.handle("text", text))
+	s.mux.HandleFunc("GET /metrics", s.serveMetrics)
+	s.mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		io.WriteString(w, "ok\n")
+	})
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Close stops the workers after the running requests are processed. Requests waiting for a worker fail.
+func (s *Server) Close() error {
+	s.pool.close()
+	return nil
+}
+
+// statusError is an error with an HTTP status code.
+type statusError struct {
+	code int
+	err  error
+}
+
+func (e *statusError) Error() string { return e.err.Error() }
+func (e *statusError) Unwrap() error { return e.err }
+
+// badRequest returns an error responded with 400 Bad Request.
+func badRequest(format string, args ...any) error {
+	return &statusError{http.StatusBadRequest, fmt.Errorf(format, args...)}
+}
+
+// result is the response of an operation: a file streamed to the client or a body in memory.
+type result struct {
+	contentType string
+	filename    string // File with the response body, body is used if empty
+	body        []byte
+	download    string // File name for Content-Disposition, none if empty
+}
+
+// jsonResult returns a result with v encoded as JSON.
+func jsonResult(v any) (*result, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &result{contentType: "application/json", body: append(body, '\n')}, nil
+}
+
+// operation processes a request on a worker.
+type operation func(req *request) (*result, error)
+
+// handle returns a handler reading the request, running op on a worker and streaming its result.
+func (s *Server) handle(endpoint string, op operation) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started := time.Now()
+		code := s.serve(w, r, op)
+		s.metrics.observe(endpoint, code, time.Since(started))
+	})
+}
+
+// serve processes a request and returns the response status code.
+func (s *Server) serve(w http.ResponseWriter, r *http.Request, op operation) int {
+	ctx, cancel := context.WithTimeout(r.Context(), s.config.Timeout)
+	defer cancel()
+
+	r.Body = http.MaxBytesReader(w, r.Body, s.config.MaxRequestSize)
+	req, err := readRequest(r, s.config.TempDir)
+	if err != nil {
+		return s.fail(w, r, err)
+	}
+
+	var res *result
+	var opErr error
+	done, err := s.pool.run(ctx, func() {
+		defer func() {
+			if p := recover(); p != nil {
+				opErr = &statusError{http.StatusInternalServerError, fmt.Errorf("panic: %v", p)}
+			}
+		}()
+		res, opErr = op(req)
+	})
+	if err != nil {
+		req.cleanup()
+		return s.fail(w, r, err)
+	}
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		// The native library cannot be interrupted, remove the files once the operation returns.
+		go func() {
+			<-done
+			req.cleanup()
+		}()
+		return s.fail(w, r, &statusError{http.StatusGatewayTimeout, errors.New("request timed out")})
+	}
+	defer req.cleanup()
+	if opErr != nil {
+		var statusErr *statusError
+		if !errors.As(opErr, &statusErr) {
+			opErr = &statusError{http.StatusUnprocessableEntity, opErr}
+		}
+		return s.fail(w, r, opErr)
+	}
+	return s.write(w, r, res)
+}
+
+// write streams the result to the client.
+func (s *Server) write(w http.ResponseWriter, r *http.Request, res *result) int {
+	body := io.Reader(nil)
+	if res.filename != "" {
+		file, err := os.Open(res.filename)
+		if err != nil {
+			return s.fail(w, r, err)
+		}
+		defer file.Close()
+		if info, err := file.Stat(); err == nil {
+			w.Header().Set("Content-Length", fmt.Sprint(info.Size()))
+		}
+		body = file
+	} else {
+		w.Header().Set("Content-Length", fmt.Sprint(len(res.body)))
+	}
+	w.Header().Set("Content-Type", res.contentType)
+	if res.download != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": res.download}))
+	}
+	w.WriteHeader(http.StatusOK)
+	if body != nil {
+		if _, err := io.Copy(w, body); err != nil {
+			s.config.ErrorLog.Printf("%s %s: failed to write response: %v", r.Method, r.URL.Path, err)
+		}
+	} else {
+		w.Write(res.body)
+	}
+	return http.StatusOK
+}
+
+// fail responds with err as JSON and returns the status code.
+func (s *Server) fail(w http.ResponseWriter, r *http.Request, err error) int {
+	code := http.StatusInternalServerError
+	var statusErr *statusError
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &statusErr):
+		code = statusErr.code
+	case errors.As(err, &maxBytesErr):
+		code = http.StatusRequestEntityTooLarge
+		err = fmt.Errorf("request body exceeds %d bytes", maxBytesErr.Limit)
+	case errors.Is(err, errBusy), errors.Is(err, errClosed):
+		code = http.StatusServiceUnavailable
+	}
+	if code >= http.StatusInternalServerError {
+		s.config.ErrorLog.Printf("%s %s: %v", r.Method, r.URL.Path, err)
+	}
+	body, _ := json.Marshal(map[string]string{"error": err.Error()})
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+	w.Write(append(body, '\n'))
+	return code
+}
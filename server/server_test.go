@@ -0,0 +1,232 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+)
+
+// testPDF returns a PDF-document with the given number of pages.
+func testPDF(t *testing.T, pages int) []byte {
+	t.Helper()
+	pdf, err := asposepdf.New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer pdf.Close()
+	for i := 1; i <= pages; i++ {
+		_ = pdf.PageAdd()
+		_ = pdf.PageAddText(int32(i), "Hello server")
+	}
+	data, err := pdf.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes(): %v", err)
+	}
+	return data
+}
+
+// multipartBody returns a multipart body with files in "file" parts and the given fields.
+func multipartBody(t *testing.T, fields map[string]string, files ...[]byte) (io.Reader, string) {
+	t.Helper()
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	for name, value := range fields {
+		_ = w.WriteField(name, value)
+	}
+	for i, data := range files {
+		part, err := w.CreateFormFile("file", "doc"+string(rune('a'+i))+".pdf")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(data)
+	}
+	w.Close()
+	return body, w.FormDataContentType()
+}
+
+func post(t *testing.T, ts *httptest.Server, path string, body io.Reader, contentType string) (*http.Response, []byte) {
+	t.Helper()
+	resp, err := http.Post(ts.URL+path, contentType, body)
+	if err != nil {
+		t.Fatalf("POST %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("POST %s: %v", path, err)
+	}
+	return resp, data
+}
+
+func newTestServer(t *testing.T, config Config) *httptest.Server {
+	t.Helper()
+	config.TempDir = t.TempDir()
+	config.ErrorLog = log.New(io.Discard, "", 0)
+	srv := New(config)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		ts.Close()
+		srv.Close()
+	})
+	return ts
+}
+
+func TestServer(t *testing.T) {
+	ts := newTestServer(t, Config{Workers: 2})
+	doc := testPDF(t, 3)
+
+	t.Run("Convert", func(t *testing.T) {
+		body, contentType := multipartBody(t, nil, doc)
+		resp, data := post(t, ts, "/convert/md", body, contentType)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status %d: %s", resp.StatusCode, data)
+		}
+		if !strings.Contains(string(data), "Hello server") {
+			t.Errorf("markdown does not contain the text: %q", data)
+		}
+		if got := resp.Header.Get("Content-Disposition"); got != "attachment; filename=doca.md" {
+			t.Errorf("Content-Disposition = %q", got)
+		}
+	})
+
+	t.Run("Render", func(t *testing.T) {
+		body, contentType := multipartBody(t, map[string]string{"page": "2", "dpi": "72"}, doc)
+		resp, data := post(t, ts, "/render/png", body, contentType)
+		if resp.StatusCode != http.StatusOK || !bytes.HasPrefix(data, []byte("\x89PNG")) {
+			t.Fatalf("status %d, %d bytes", resp.StatusCode, len(data))
+		}
+		body, contentType = multipartBody(t, nil, doc)
+		if resp, _ := post(t, ts, "/render/png?page=9", body, contentType); resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("page out of range: status %d, want 400", resp.StatusCode)
+		}
+	})
+
+	t.Run("MergeSplit", func(t *testing.T) {
+		body, contentType := multipartBody(t, nil, doc, testPDF(t, 2))
+		resp, merged := post(t, ts, "/merge", body, contentType)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("merge: status %d: %s", resp.StatusCode, merged)
+		}
+		body, contentType = multipartBody(t, nil, merged)
+		resp, data := post(t, ts, "/split?pages=1-3&pages=4-", body, contentType)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("split: status %d: %s", resp.StatusCode, data)
+		}
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("split: %v", err)
+		}
+		if len(archive.File) != 2 || archive.File[1].Name != "doca-2.pdf" {
+			t.Errorf("split: %d files", len(archive.File))
+		}
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		body, contentType := multipartBody(t, map[string]string{"format": "PDF_A_1B"}, doc)
+		resp, data := post(t, ts, "/validate", body, contentType)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status %d: %s", resp.StatusCode, data)
+		}
+		var report struct {
+			Valid bool   `json:"valid"`
+			Log   string `json:"log"`
+		}
+		if err := json.Unmarshal(data, &report); err != nil {
+			t.Fatalf("%v: %s", err, data)
+		}
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		body, contentType := multipartBody(t, nil, doc)
+		resp, data := post(t, ts, "/text", body, contentType)
+		if resp.StatusCode != http.StatusOK || !strings.Contains(string(data), "Hello server") {
+			t.Fatalf("status %d: %q", resp.StatusCode, data)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			path  string
+			files [][]byte
+			code  int
+		}{
+			{"/convert/bogus", [][]byte{doc}, http.StatusBadRequest},
+			{"/convert/docx", nil, http.StatusBadRequest},
+			{"/convert/docx", [][]byte{[]byte("not a PDF")}, http.StatusUnprocessableEntity},
+			{"/optimize?quality=500", [][]byte{doc}, http.StatusBadRequest},
+			{"/sign", [][]byte{doc}, http.StatusBadRequest},
+		}
+		for _, test := range tests {
+			body, contentType := multipartBody(t, nil, test.files...)
+			resp, data := post(t, ts, test.path, body, contentType)
+			if resp.StatusCode != test.code || !strings.Contains(string(data), `"error"`) {
+				t.Errorf("POST %s: status %d, want %d: %s", test.path, resp.StatusCode, test.code, data)
+			}
+		}
+		if resp, _ := post(t, ts, "/text", strings.NewReader("{}"), "application/json"); resp.StatusCode != http.StatusUnsupportedMediaType {
+			t.Errorf("JSON body: status %d, want 415", resp.StatusCode)
+		}
+	})
+
+	t.Run("Metrics", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/metrics")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		for _, want := range []string{
+			`asposepdf_requests_total{endpoint="convert",code="200"} 1`,
+			`asposepdf_requests_total{endpoint="convert",code="400"} 2`,
+			`asposepdf_request_duration_seconds_count{endpoint="text"}`,
+			"asposepdf_workers 2",
+		} {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("metrics do not contain %q", want)
+			}
+		}
+	})
+}
+
+func TestServerLimits(t *testing.T) {
+	ts := newTestServer(t, Config{Workers: 1, MaxRequestSize: 1024, Timeout: time.Second})
+	body, contentType := multipartBody(t, nil, bytes.Repeat([]byte("x"), 4096))
+	resp, data := post(t, ts, "/optimize", body, contentType)
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("status %d, want 413: %s", resp.StatusCode, data)
+	}
+}
+
+func TestPool(t *testing.T) {
+	p := newPool(1)
+	release := make(chan struct{})
+	done, err := p.run(context.Background(), func() { <-release })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The only worker is busy
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := p.run(ctx, func() {}); err != errBusy {
+		t.Errorf("run() = %v, want errBusy", err)
+	}
+
+	close(release)
+	<-done
+	p.close()
+	if _, err := p.run(context.Background(), func() {}); err != errClosed {
+		t.Errorf("run() after close = %v, want errClosed", err)
+	}
+}
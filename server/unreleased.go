@@ -0,0 +1,17 @@
+//go:build asposepdf_unreleased
+
+// Conversions using the wrappers of unreleased native functions,
+// built with -tags asposepdf_unreleased like the asposepdf package.
+
+package server
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+
+func init() {
+	conversions["html"] = conversion{".html", "text/html; charset=utf-8", func(pdf *asposepdf.Document, filename string) error {
+		return pdf.SaveHtml(filename, nil)
+	}}
+	conversions["htmlzip"] = conversion{".zip", "application/zip", func(pdf *asposepdf.Document, filename string) error {
+		return pdf.SaveHtml(filename, &asposepdf.HtmlOptions{SplitPages: true, ExternalImages: true, ExternalFonts: true, ExternalCss: true})
+	}}
+}
@@ -0,0 +1,235 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Default size in bytes reserved for the signature container.
+const defaultSignatureReservedSize = 16384
+
+// Signature dictionary sub-filters.
+const (
+	subFilterPKCS7Detached = "adbe.pkcs7.detached"
+	subFilterCAdESDetached = "ETSI.CAdES.detached"
+	subFilterRFC3161       = "ETSI.RFC3161"
+)
+
+// SignOptions contains settings for signing a PDF-document.
+//
+// A new signature field is created unless FieldName names an existing empty signature field,
+// in which case that field is signed. A certifying signature (Certification other than NotCertified)
+// must be the first signature of the document.
+type SignOptions struct {
+	FieldName          string             `json:"fieldname"`          // Name of the signature field, generated if empty
+	Page               int32              `json:"page"`               // Page number of the signature field, 1 if zero
+	Pages              []int32            `json:"pages"`              // Page numbers to show the signature on, overrides Page if set
+	X                  int32              `json:"x"`                  // X indent of the visible signature
+	Y                  int32              `json:"y"`                  // Y indent of the visible signature
+	Width              int32              `json:"width"`              // Width of the visible signature
+	Height             int32              `json:"height"`             // Height of the visible signature
+	Reason             string             `json:"reason"`             // Reason of signing
+	Contact            string             `json:"contact"`            // Contact information of the signer
+	Location           string             `json:"location"`           // Location of signing
+	Visible            bool               `json:"visible"`            // Show the signature on the page
+	Appearance         []byte             `json:"appearance"`         // Image of the visible signature
+	AppearanceText     string             `json:"appearancetext"`     // Text of the visible signature; {signer}, {date}, {reason}, {location} and {contact} are replaced
+	AppearanceFontSize float64            `json:"appearancefontsize"` // Font size of AppearanceText, fitted to the field if zero
+	Hash               crypto.Hash        `json:"-"`                  // Digest algorithm: crypto.SHA256, crypto.SHA384 or crypto.SHA512; SHA-256 if zero; always SHA-512 for Ed25519 signers
+	Certification      CertificationLevel `json:"certification"`      // Certification (DocMDP) level
+	ReservedSize       int32              `json:"reservedsize"`       // Bytes reserved for the signature container, 16384 if zero
+}
+
+// ExternalSignature is a PDF-document saved with an empty signature placeholder,
+// waiting for a CMS signature produced outside of the library (e.g. by an HSM or KMS).
+type ExternalSignature struct {
+	Filename  string      // File with the signature placeholder
+	ByteRange []int64     // Signed byte range as offset/length pairs
+	Hash      crypto.Hash // Hash algorithm of Digest
+	Digest    []byte      // Digest of the signed byte range
+}
+
+// normalize returns a copy of options with defaults applied.
+func (options *SignOptions) normalize() *SignOptions {
+	normalized := SignOptions{}
+	if options != nil {
+		normalized = *options
+	}
+	if normalized.Page == 0 {
+		normalized.Page = 1
+	}
+	if normalized.Hash == 0 {
+		normalized.Hash = crypto.SHA256
+	}
+	if normalized.ReservedSize == 0 {
+		normalized.ReservedSize = defaultSignatureReservedSize
+	}
+	return &normalized
+}
+
+// forSigner returns options with the digest algorithm required by the signer's key.
+// RFC 8419 requires SHA-512 for Ed25519 signatures with signed attributes.
+func (options *SignOptions) forSigner(signer crypto.Signer) *SignOptions {
+	if _, ok := signer.Public().(ed25519.PublicKey); !ok {
+		return options
+	}
+	normalized := options.normalize()
+	normalized.Hash = crypto.SHA512
+	return normalized
+}
+
+// marshal encodes options with defaults applied and the subFilter for the native library.
+func (options *SignOptions) marshal(subFilter string) (string, error) {
+	normalized := options.normalize()
+	if _, ok := cmsDigestAlgorithms[normalized.Hash]; !ok {
+		return "", fmt.Errorf("unsupported hash algorithm %v", normalized.Hash)
+	}
+	options_json, err := json.Marshal(struct {
+		*SignOptions
+		Hash      string `json:"hash"`
+		SubFilter string `json:"subfilter"`
+	}{normalized, normalized.Hash.String(), subFilter})
+	if err != nil {
+		return "", err
+	}
+	return string(options_json), nil
+}
+
+// PrepareSignature saves PDF-document with filename and an empty signature placeholder,
+// and returns the digest of the byte range to be signed.
+//
+// If PDF-document is already signed, the placeholder is appended as an incremental update.
+// The signature is completed with ExternalSignature.Inject.
+//
+// Example:
+//
+//	prepared, err := pdf.PrepareSignature(&SignOptions{Reason: "Approved"}, "prepared.pdf")
+//	cms, err := hsm.SignDigest(prepared.Digest)
+//	err = prepared.Inject(cms)
+func (document *Document) PrepareSignature(options *SignOptions, filename string) (*ExternalSignature, error) {
+	signature, err := document.prepareExternalSignature(options, subFilterPKCS7Detached, filename)
+	if err != nil {
+		return nil, fmt.Errorf("PrepareSignature(): %w", err)
+	}
+	return signature, nil
+}
+
+// prepareExternalSignature saves PDF-document with a placeholder of subFilter type and computes its digest.
+func (document *Document) prepareExternalSignature(options *SignOptions, subFilter string, filename string) (*ExternalSignature, error) {
+	normalized := options.normalize()
+	byteRange, err := document.prepareSignature(normalized, subFilter, filename)
+	if err != nil {
+		return nil, err
+	}
+	if len(byteRange) != 4 {
+		return nil, fmt.Errorf("invalid byte range %v", byteRange)
+	}
+
+	signature := &ExternalSignature{Filename: filename, ByteRange: byteRange, Hash: normalized.Hash}
+	if signature.Digest, err = signature.digest(); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// digest computes the digest of the signed byte range of the prepared file.
+func (signature *ExternalSignature) digest() ([]byte, error) {
+	file, err := os.Open(signature.Filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	h := signature.Hash.New()
+	for i := 0; i+1 < len(signature.ByteRange); i += 2 {
+		section := io.NewSectionReader(file, signature.ByteRange[i], signature.ByteRange[i+1])
+		if _, err := io.Copy(h, section); err != nil {
+			return nil, err
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+// Inject writes the DER-encoded CMS signature into the placeholder of the prepared file.
+//
+// Example:
+//
+//	err := prepared.Inject(cms)
+func (signature *ExternalSignature) Inject(cms []byte) error {
+	if len(signature.ByteRange) != 4 {
+		return fmt.Errorf("Inject(): invalid byte range %v", signature.ByteRange)
+	}
+	start := signature.ByteRange[0] + signature.ByteRange[1]
+	end := signature.ByteRange[2]
+	capacity := int(end-start) - 2
+	if len(cms)*2 > capacity {
+		return fmt.Errorf("Inject(): signature of %d bytes does not fit into %d reserved bytes", len(cms), capacity/2)
+	}
+
+	file, err := os.OpenFile(signature.Filename, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("Inject(): %w", err)
+	}
+	defer file.Close()
+
+	delimiter := make([]byte, 1)
+	for offset, expected := range map[int64]byte{start: '<', end - 1: '>'} {
+		if _, err := file.ReadAt(delimiter, offset); err != nil {
+			return fmt.Errorf("Inject(): %w", err)
+		}
+		if delimiter[0] != expected {
+			return errors.New("Inject(): signature placeholder not found")
+		}
+	}
+
+	contents := make([]byte, capacity)
+	hex.Encode(contents, cms)
+	for i := len(cms) * 2; i < capacity; i++ {
+		contents[i] = '0'
+	}
+	if _, err := file.WriteAt(contents, start+1); err != nil {
+		return fmt.Errorf("Inject(): %w", err)
+	}
+	return nil
+}
+
+// SignWithSigner signs a PDF-document with a crypto.Signer and saves it with filename.
+//
+// The private key never leaves the signer, so keys kept in an HSM or KMS can be used.
+// The chain starts with the signer's certificate followed by its issuers.
+//
+// Example:
+//
+//	err := pdf.SignWithSigner(key, []*x509.Certificate{cert, ca}, &SignOptions{Reason: "Approved"}, "signed.pdf")
+func (document *Document) SignWithSigner(signer crypto.Signer, chain []*x509.Certificate, options *SignOptions, filename string) error {
+	if signer == nil || len(chain) == 0 {
+		return errors.New("SignWithSigner(): signer and certificate chain are required")
+	}
+	prepared, err := document.PrepareSignature(options.forSigner(signer), filename)
+	if err != nil {
+		return fmt.Errorf("SignWithSigner(): %w", err)
+	}
+	cms, err := (&cmsSigner{
+		signer:      signer,
+		chain:       chain,
+		hash:        prepared.Hash,
+		signingTime: time.Now(),
+	}).sign(prepared.Digest)
+	if err != nil {
+		return fmt.Errorf("SignWithSigner(): %w", err)
+	}
+	if err := prepared.Inject(cms); err != nil {
+		return fmt.Errorf("SignWithSigner(): %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"errors"
+	"io"
+)
+
+// Sign signs a PDF-document with a PKCS#12 certificate and saves it in place as an incremental update.
+//
+// Like Save, it writes to the file PDF-document was opened from; use SignAs or SignTo for a PDF-document created with New.
+//
+// Example:
+//
+//	err := pdf.Sign(certBytes, "password123", &SignOptions{FieldName: "Approval", Reason: "Approved"})
+func (document *Document) Sign(signData []byte, pswSign string, options *SignOptions) error {
+	return document.sign(signData, pswSign, options, nil)
+}
+
+// SignAs signs a PDF-document with a PKCS#12 certificate and saves it with filename.
+//
+// Example:
+//
+//	err := pdf.SignAs(certBytes, "password123", &SignOptions{Reason: "Approved", Hash: crypto.SHA384, Certification: CertifiedFormFilling}, "filename_Signed.pdf")
+func (document *Document) SignAs(signData []byte, pswSign string, options *SignOptions, filename string) error {
+	if filename == "" {
+		return errors.New("SignAs(): filename is empty")
+	}
+	return document.sign(signData, pswSign, options, &filename)
+}
+
+// SignTo signs a PDF-document with a PKCS#12 certificate and writes the signed PDF-document to w.
+//
+// Example:
+//
+//	err := pdf.SignTo(certBytes, "password123", &SignOptions{Reason: "Approved"}, w)
+func (document *Document) SignTo(signData []byte, pswSign string, options *SignOptions, w io.Writer) error {
+	return document.signTo(signData, pswSign, options, w)
+}
@@ -0,0 +1,222 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Errors returned by SignatureInfo.Verify.
+var (
+	ErrSignatureModified  = errors.New("signed content has been modified")
+	ErrCertificateRevoked = errors.New("certificate has been revoked")
+	ErrRevocationUnknown  = errors.New("no revocation data for certificate")
+)
+
+// SignatureInfo contains information about a digital signature of a PDF-document.
+type SignatureInfo struct {
+	Name                string              `json:"name"`                // Name of the signature field
+	SignerName          string              `json:"signername"`          // Name of the signer
+	SigningTime         time.Time           `json:"signingtime"`         // Signing time claimed by the signer
+	Reason              string              `json:"reason"`              // Reason of signing
+	Location            string              `json:"location"`            // Location of signing
+	Contact             string              `json:"contact"`             // Contact information of the signer
+	SubFilter           string              `json:"subfilter"`           // Signature format, e.g. ETSI.CAdES.detached or ETSI.RFC3161
+	ByteRange           []int64             `json:"byterange"`           // Signed byte range as offset/length pairs
+	CoversWholeDocument bool                `json:"coverswholedocument"` // Signature covers all revisions of the file
+	Certification       CertificationLevel  `json:"certification"`       // Certification (DocMDP) level of the signature
+	RawCertificates     [][]byte            `json:"certificates"`        // DER-encoded certificates, the signer's first
+	Certificates        []*x509.Certificate `json:"-"`                   // Parsed certificates, the signer's first
+
+	document *Document
+}
+
+// VerifyOptions contains settings for SignatureInfo.Verify.
+//
+// All revocation data is supplied by the caller, Verify does not access the network.
+type VerifyOptions struct {
+	Roots                  *x509.CertPool // Trusted root certificates; the system pool is used if nil
+	Intermediates          *x509.CertPool // Additional intermediate certificates
+	CurrentTime            time.Time      // Time to validate the certificates at, e.g. a verified timestamp time; time.Now() is used if zero
+	CRLs                   [][]byte       // DER-encoded certificate revocation lists
+	OCSPResponses          [][]byte       // DER-encoded OCSP responses
+	RequireRevocationCheck bool           // Fail if a certificate of the chain has no revocation data
+}
+
+// parseCertificates fills Certificates from RawCertificates.
+func (signature *SignatureInfo) parseCertificates() error {
+	signature.Certificates = make([]*x509.Certificate, 0, len(signature.RawCertificates))
+	for i, raw := range signature.RawCertificates {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("signature %q: failed to parse certificate at index %d: %w", signature.Name, i, err)
+		}
+		signature.Certificates = append(signature.Certificates, cert)
+	}
+	return nil
+}
+
+// Verify checks integrity of the signed content and validates the signer's certificate chain.
+//
+// The returned error wraps ErrSignatureModified, ErrCertificateRevoked or ErrRevocationUnknown,
+// or an x509 error if the chain cannot be built.
+//
+// Example:
+//
+//	err := signature.Verify(&VerifyOptions{Roots: roots, CRLs: [][]byte{crl}})
+func (signature *SignatureInfo) Verify(options *VerifyOptions) error {
+	if signature.document == nil || signature.document.pdf == nil {
+		return fmt.Errorf("Verify(%q): PDF-document is closed", signature.Name)
+	}
+	if options == nil {
+		options = &VerifyOptions{}
+	}
+
+	valid, err := signature.document.verifySignature(signature.Name)
+	if err != nil {
+		return fmt.Errorf("Verify(%q): %w", signature.Name, err)
+	}
+	if !valid {
+		return fmt.Errorf("Verify(%q): %w", signature.Name, ErrSignatureModified)
+	}
+
+	if len(signature.Certificates) == 0 {
+		return fmt.Errorf("Verify(%q): signature contains no certificates", signature.Name)
+	}
+
+	// SigningTime is claimed by the signer and may be backdated, so it is never used as the check time.
+	checkTime := options.CurrentTime
+	if checkTime.IsZero() {
+		checkTime = time.Now()
+	}
+
+	intermediates := x509.NewCertPool()
+	if options.Intermediates != nil {
+		intermediates = options.Intermediates.Clone()
+	}
+	for _, cert := range signature.Certificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	chains, err := signature.Certificates[0].Verify(x509.VerifyOptions{
+		Roots:         options.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   checkTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("Verify(%q): %w", signature.Name, err)
+	}
+
+	if err := checkChains(chains, options, checkTime); err != nil {
+		return fmt.Errorf("Verify(%q): %w", signature.Name, err)
+	}
+	return nil
+}
+
+// checkChains checks the revocation of every verified chain and accepts the first one passing the check.
+//
+// Cross-signed intermediates may build several chains to the roots; a revoked certificate
+// in one of them does not reject the signature if another chain passes. The error of the first chain is returned
+// if no chain passes.
+func checkChains(chains [][]*x509.Certificate, options *VerifyOptions, checkTime time.Time) error {
+	var first error
+	for _, chain := range chains {
+		err := checkRevocation(chain, options, checkTime)
+		if err == nil {
+			return nil
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// checkRevocation checks every non-root certificate of the chain against the supplied CRLs and OCSP responses.
+//
+// A revocation before checkTime is reported by any CRL or OCSP response signed by the issuer,
+// but only data covering checkTime (see current) counts as a revocation check.
+func checkRevocation(chain []*x509.Certificate, options *VerifyOptions, checkTime time.Time) error {
+	crls := make([]*x509.RevocationList, 0, len(options.CRLs))
+	for i, raw := range options.CRLs {
+		crl, err := x509.ParseRevocationList(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse CRL at index %d: %w", i, err)
+		}
+		crls = append(crls, crl)
+	}
+
+	responses := make([]*ocspResponse, 0, len(options.OCSPResponses))
+	for i, raw := range options.OCSPResponses {
+		response, err := parseOCSPResponse(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse OCSP response at index %d: %w", i, err)
+		}
+		responses = append(responses, response)
+	}
+
+	for i := 0; i+1 < len(chain); i++ {
+		cert, issuer := chain[i], chain[i+1]
+		checked := false
+
+		for _, crl := range crls {
+			if crl.CheckSignatureFrom(issuer) != nil {
+				continue
+			}
+			if current(crl.ThisUpdate, crl.NextUpdate, checkTime) {
+				checked = true
+			}
+			for _, entry := range crl.RevokedCertificateEntries {
+				if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 && !entry.RevocationTime.After(checkTime) {
+					return fmt.Errorf("%w: %s (CRL)", ErrCertificateRevoked, cert.Subject)
+				}
+			}
+		}
+
+		for _, response := range responses {
+			status, ok := response.status(cert, issuer)
+			if !ok {
+				continue
+			}
+			if current(status.thisUpdate, status.nextUpdate, checkTime) {
+				checked = true
+			}
+			if status.revoked && !status.revocationTime.After(checkTime) {
+				return fmt.Errorf("%w: %s (OCSP)", ErrCertificateRevoked, cert.Subject)
+			}
+		}
+
+		if !checked && options.RequireRevocationCheck {
+			return fmt.Errorf("%w: %s", ErrRevocationUnknown, cert.Subject)
+		}
+	}
+	return nil
+}
+
+// current reports whether revocation data issued at thisUpdate and valid until nextUpdate covers checkTime:
+// it must not be issued in the future and must not expire before checkTime.
+// Data issued after checkTime covers it, as it reports all revocations up to its issue time.
+// A zero nextUpdate means that newer data is available at any time.
+func current(thisUpdate, nextUpdate, checkTime time.Time) bool {
+	if thisUpdate.After(time.Now()) {
+		return false
+	}
+	return nextUpdate.IsZero() || !checkTime.After(nextUpdate)
+}
+
+// Signatures returns information about digital signatures of PDF-document.
+//
+// The returned signatures can be verified with SignatureInfo.Verify while PDF-document is open.
+//
+// See also: signature.go
+//
+// Example:
+//
+//	signatures, err := pdf.Signatures()
+func (document *Document) Signatures() ([]*SignatureInfo, error) {
+	return document.signatures()
+}
@@ -0,0 +1,105 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// SignatureFieldOptions contains settings for AddSignatureField.
+type SignatureFieldOptions struct {
+	Lock        FieldLockAction    `json:"lock"`        // Fields locked when the field is signed (field MDP)
+	LockFields  []string           `json:"lockfields"`  // Names of fields for LockInclude and LockExclude
+	Permissions CertificationLevel `json:"permissions"` // Document changes allowed after the field is signed, NotCertified to keep them unrestricted
+}
+
+// SignatureFieldInfo contains information about a signature field of a PDF-document.
+type SignatureFieldInfo struct {
+	Name        string             `json:"name"`        // Name of the signature field
+	Page        int32              `json:"page"`        // Page number of the signature field
+	Rect        Rectangle          `json:"rect"`        // Position of the signature field on the page
+	Signed      bool               `json:"signed"`      // The field contains a signature
+	Lock        FieldLockAction    `json:"lock"`        // Fields locked when the field is signed
+	LockFields  []string           `json:"lockfields"`  // Names of fields for LockInclude and LockExclude
+	Permissions CertificationLevel `json:"permissions"` // Document changes allowed after the field is signed
+}
+
+// AddSignatureField adds an empty signature field to be signed later with SignField.
+//
+// Example:
+//
+//	err := pdf.AddSignatureField(1, Rectangle{X: 100, Y: 100, Width: 200, Height: 70}, "Buyer", &SignatureFieldOptions{Lock: LockAll})
+func (document *Document) AddSignatureField(num int32, rect Rectangle, name string, options *SignatureFieldOptions) error {
+	if name == "" {
+		return errors.New("AddSignatureField(): name is empty")
+	}
+	if options == nil {
+		options = &SignatureFieldOptions{}
+	}
+	options_json, e := json.Marshal(struct {
+		Rect Rectangle `json:"rect"`
+		*SignatureFieldOptions
+	}{rect, options})
+	if e != nil {
+		return e
+	}
+	return document.addSignatureField(num, name, string(options_json))
+}
+
+// SignatureFields returns information about signature fields of PDF-document, signed or not.
+//
+// Example:
+//
+//	fields, err := pdf.SignatureFields()
+func (document *Document) SignatureFields() ([]SignatureFieldInfo, error) {
+	return document.signatureFields()
+}
+
+// UnsignedSignatureFields returns information about signature fields of PDF-document waiting to be signed.
+//
+// Example:
+//
+//	fields, err := pdf.UnsignedSignatureFields()
+func (document *Document) UnsignedSignatureFields() ([]SignatureFieldInfo, error) {
+	fields, err := document.SignatureFields()
+	if err != nil {
+		return nil, err
+	}
+	unsigned := make([]SignatureFieldInfo, 0, len(fields))
+	for _, field := range fields {
+		if !field.Signed {
+			unsigned = append(unsigned, field)
+		}
+	}
+	return unsigned, nil
+}
+
+// SignField signs the existing empty signature field name and saves PDF-document with filename.
+//
+// Position settings of options are ignored, the signature is placed into the field.
+// SignField always saves a new file; to sign the field in place, call Sign with SignOptions.FieldName set to name.
+//
+// Example:
+//
+//	err := pdf.SignField("Buyer", certBytes, "password123", &SignOptions{Reason: "Agreed"}, "filename_Signed.pdf")
+func (document *Document) SignField(name string, signData []byte, pswSign string, options *SignOptions, filename string) error {
+	fields, err := document.UnsignedSignatureFields()
+	if err != nil {
+		return fmt.Errorf("SignField(%q): %w", name, err)
+	}
+	for _, field := range fields {
+		if field.Name != name {
+			continue
+		}
+		fieldOptions := options.normalize()
+		fieldOptions.FieldName = field.Name
+		fieldOptions.Page = field.Page
+		fieldOptions.Pages = nil
+		fieldOptions.X, fieldOptions.Y = field.Rect.X, field.Rect.Y
+		fieldOptions.Width, fieldOptions.Height = field.Rect.Width, field.Rect.Height
+		return document.SignAs(signData, pswSign, fieldOptions, filename)
+	}
+	return fmt.Errorf("SignField(%q): no empty signature field with this name", name)
+}
@@ -0,0 +1,42 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+import "os"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	data, err := os.Open("data.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer data.Close()
+	// AddAttachment(r io.Reader, options *AttachmentOptions) attaches a file read from r to PDF-document
+	err = pdf.AddAttachment(data, &asposepdf.AttachmentOptions{Name: "data.csv", Description: "Source data", Relationship: asposepdf.AFData})
+	if err != nil {
+		log.Fatal(err)
+	}
+	notes, err := os.Open("notes.txt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer notes.Close()
+	// PageAddFileAttachment(num int32, rect Rectangle, r io.Reader, options *AttachmentOptions) adds a file attachment annotation to page
+	err = pdf.PageAddFileAttachment(1, asposepdf.Rectangle{X: 500, Y: 750, Width: 20, Height: 20}, notes, &asposepdf.AttachmentOptions{Name: "notes.txt", Icon: "Paperclip"})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_AddAttachment.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
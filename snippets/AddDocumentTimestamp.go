@@ -0,0 +1,22 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// AddDocumentTimestamp adds an RFC 3161 document timestamp to PDF-document and saves it with filename
+	err = pdf.AddDocumentTimestamp(&asposepdf.HTTPTimestampClient{URL: "http://timestamp.example.com"}, "sample_AddDocumentTimestamp.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,44 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+
+func main() {
+	// Bates numbering continues across all files of the production
+	bates := &asposepdf.BatesNumbering{Prefix: "ACME", Start: 1, Digits: 6}
+
+	for _, filename := range []string{"sample.pdf", "sample_Append.pdf"} {
+		// Open(filename string) opens a PDF-document with filename
+		pdf, err := asposepdf.Open(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		// AddHeader(template *HeaderFooter) stamps the header template on pages of PDF-document
+		_, err = pdf.AddHeader(&asposepdf.HeaderFooter{Left: "{title}", Right: "{date}", FontSize: 9})
+		if err != nil {
+			log.Fatal(err)
+		}
+		// AddFooter(template *HeaderFooter) stamps the footer template on pages of PDF-document
+		// and returns the number of stamped pages
+		stamped, err := pdf.AddFooter(&asposepdf.HeaderFooter{
+			Left:            "Page {page} of {pages}",
+			Right:           "{bates}",
+			MirrorEvenPages: true,
+			Bates:           bates,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		bates.Start += int64(stamped)
+		// SaveAs(filename string) saves previously opened PDF-document with new filename
+		err = pdf.SaveAs(fmt.Sprintf("bates_%s", filename))
+		if err != nil {
+			log.Fatal(err)
+		}
+		// Close() releases allocated resources for PDF-document
+		pdf.Close()
+	}
+}
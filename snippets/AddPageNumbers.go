@@ -0,0 +1,30 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// AddPageNumbers(options *PageNumberOptions) stamps page numbers on pages of PDF-document
+	err = pdf.AddPageNumbers(&asposepdf.PageNumberOptions{
+		Position:     asposepdf.PositionBottomRight,
+		Format:       "Page {page} of {pages}",
+		ExcludePages: "1",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_AddPageNumbers.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,31 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// AddSignatureField adds an empty signature field to be signed later
+	err = pdf.AddSignatureField(1, asposepdf.Rectangle{X: 50, Y: 50, Width: 200, Height: 70}, "Seller", &asposepdf.SignatureFieldOptions{Lock: asposepdf.LockAll})
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = pdf.AddSignatureField(1, asposepdf.Rectangle{X: 300, Y: 50, Width: 200, Height: 70}, "Buyer", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_AddSignatureField.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
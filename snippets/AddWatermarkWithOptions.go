@@ -0,0 +1,51 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+import "os"
+
+func main() {
+	logo, err := os.ReadFile("sign.png")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// AddWatermarkWithOptions(options *WatermarkOptions) adds a tiled diagonal text watermark
+	opacity := 0.2
+	err = pdf.AddWatermarkWithOptions(&asposepdf.WatermarkOptions{
+		Text:         "CONFIDENTIAL - John Doe",
+		FontSize:     24,
+		Color:        "#FF0000",
+		Rotation:     45,
+		Opacity:      &opacity,
+		Tiled:        true,
+		TileSpacingX: 60,
+		TileSpacingY: 60,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// AddWatermarkWithOptions(options *WatermarkOptions) adds an image watermark to the corner of pages 2 and later
+	err = pdf.AddWatermarkWithOptions(&asposepdf.WatermarkOptions{
+		Image:     logo,
+		Position:  asposepdf.PositionBottomRight,
+		PageRange: "2-",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_AddWatermarkWithOptions.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,36 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+import "os"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// Attachments() returns information about files attached to PDF-document
+	attachments, err := pdf.Attachments()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, attachment := range attachments {
+		fmt.Printf("%s (%s, %d bytes, page %d): %s\n", attachment.Name, attachment.MimeType, attachment.Size, attachment.Page, attachment.Description)
+		file, err := os.Create(attachment.Name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		// ExtractAttachment(name string, w io.Writer) writes the contents of the attached file with name to w
+		err = pdf.ExtractAttachment(attachment.Name, file)
+		file.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
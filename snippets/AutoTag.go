@@ -0,0 +1,36 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// AutoTag() creates the logical structure tree of an untagged PDF-document from its layout
+	err = pdf.AutoTag()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SetLanguage(language string) sets the natural language of PDF-document
+	err = pdf.SetLanguage("en-US")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SetTitle(title string) sets the title of PDF-document
+	err = pdf.SetTitle("Sample document")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_AutoTag.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+	"log"
+)
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// ConvertWithReport(pdfFormat PdfFormat, action ConvertErrorAction) converts PDF-document and returns a structured report
+	report, err := pdf.ConvertWithReport(asposepdf.PDF_A_2A, asposepdf.Delete)
+	if err != nil {
+		log.Fatal("Convert PDF/A error:", err)
+	}
+
+	// Print conversion result and issues not fixed by the conversion
+	fmt.Printf("Convert PDF/A result: %v, %d of %d issues fixed\n", report.Valid, report.Fixed, len(report.Issues))
+	for _, issue := range report.Remaining() {
+		fmt.Printf("%s %s page %d: %s\n", issue.Severity, issue.Clause, issue.Page, issue.Message)
+	}
+
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_ConvertWithReport.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,31 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+import "os"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	invoice, err := os.ReadFile("factur-x.xml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// CreateEInvoice(invoiceXML []byte, profile EInvoiceProfile) makes PDF-document a ZUGFeRD / Factur-X e-invoice
+	err = pdf.CreateEInvoice(invoice, asposepdf.EInvoiceAuto)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_CreateEInvoice.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
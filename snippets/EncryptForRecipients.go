@@ -0,0 +1,44 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "crypto/x509"
+import "encoding/pem"
+import "log"
+import "os"
+
+func main() {
+	data, err := os.ReadFile("recipient.crt")
+	if err != nil {
+		log.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		log.Fatal("recipient.crt: no PEM data")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// EncryptForRecipients(recipients, cryptoAlgorithm) encrypts PDF-document with public-key security
+	err = pdf.EncryptForRecipients([]asposepdf.Recipient{
+		{Certificate: cert, Permissions: asposepdf.PrintDocument | asposepdf.FillForm},
+	}, asposepdf.AESx256)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_with_certificate.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,26 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+import "fmt"
+
+func main() {
+	// OpenWithPassword(filename string, password string) opens a password-protected PDF-document
+	pdf, err := asposepdf.OpenWithPassword("sample_with_password.pdf", "ownerpass")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// EncryptionInfo() returns details of the encryption of PDF-document
+	info, err := pdf.EncryptionInfo()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Handler: %s, revision: %d, key length: %d, encrypt metadata: %t\n", info.SecurityHandler, info.Revision, info.KeyLength, info.EncryptMetadata)
+	if info.Algorithm == asposepdf.RC4x40 {
+		fmt.Println("Legacy RC4 40-bit encryption, re-encrypt with AESx256")
+	}
+}
@@ -0,0 +1,28 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+import "os"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample_CreateEInvoice.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// ExtractEInvoice() returns the attached invoice XML and its profile
+	invoice, profile, err := pdf.ExtractEInvoice()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Profile:", profile)
+	err = os.WriteFile("sample_ExtractEInvoice.xml", invoice, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
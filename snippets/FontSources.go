@@ -0,0 +1,47 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+import "os"
+
+func main() {
+	// AddFontDirectory(dir string) registers a directory with fonts
+	err := asposepdf.AddFontDirectory("fonts")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// AddFontData(data []byte) registers an in-memory TrueType or OpenType font
+	font, err := os.ReadFile("CorporateSans.ttf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = asposepdf.AddFontData(font)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SetFontSubstitution(fontName string, fallbacks ...string) sets the fallback chain for a missing font
+	err = asposepdf.SetFontSubstitution("Helvetica", "CorporateSans", "Arial")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// EmbedFonts() embeds fonts a PDF-document
+	err = pdf.EmbedFonts()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_FontSources.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,25 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// Fonts() returns information about fonts used by PDF-document
+	fonts, err := pdf.Fonts()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, font := range fonts {
+		fmt.Printf("%s: type %d, embedded %t, subset %t, encoding %s, pages %v\n", font.Name, font.Type, font.Embedded, font.Subset, font.Encoding, font.Pages)
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+import "fmt"
+import "encoding/json"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// Inspect() returns a profile of PDF-document
+	report, err := pdf.Inspect()
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("PDF %s by %s: %d pages, %d images, %d attachments\n", report.Version, report.Producer, report.PageCount, report.ImageCount, len(report.Attachments))
+	// Print as JSON
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(data))
+}
@@ -0,0 +1,19 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+
+func main() {
+	// MergeFiles(filename string, inputs []string, options *MergeOptions) merges PDF-documents from files one by one
+	err := asposepdf.MergeFiles("sample_MergeFiles.pdf", []string{"sample.pdf", "sample1page.pdf"}, &asposepdf.MergeOptions{
+		BookmarkPerInput:     true,
+		FieldNameConflict:    asposepdf.FieldNameRename,
+		TableOfContents:      true,
+		TableOfContentsTitle: "Contents",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,28 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "crypto/tls"
+import "crypto/x509"
+import "log"
+
+func main() {
+	pair, err := tls.LoadX509KeyPair("recipient.crt", "recipient.key")
+	if err != nil {
+		log.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// OpenWithCertificate(filename, cert, key) opens a PDF-document encrypted with public-key security
+	pdf, err := asposepdf.OpenWithCertificate("sample_with_certificate.pdf", cert, pair.PrivateKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// working...
+}
@@ -0,0 +1,42 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// SetPageLabels(ranges []PageLabelRange) sets roman-numbered front matter followed by decimal pages
+	err = pdf.SetPageLabels([]asposepdf.PageLabelRange{
+		{StartPage: 1, Style: asposepdf.NumberingRomanLower},
+		{StartPage: 3, Style: asposepdf.NumberingDecimal},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	// PageLabel(num int32) returns the label of page
+	label, err := pdf.PageLabel(2)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Page 2 is labeled", label)
+	// ResolvePageLabels(pagerange string) converts a page range of labels into page numbers
+	pagerange, err := pdf.ResolvePageLabels("i-ii")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Front matter pages:", pagerange)
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_PageLabels.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
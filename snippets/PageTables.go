@@ -0,0 +1,35 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+import "os"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// PageTables(num int32) returns the tables found on page
+	tables, err := pdf.PageTables(1)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i, table := range tables {
+		file, err := os.Create(fmt.Sprintf("sample_PageTables_%d.csv", i+1))
+		if err != nil {
+			log.Fatal(err)
+		}
+		// WriteCSV(w io.Writer) writes the text of the table to w as CSV
+		err = table.WriteCSV(file)
+		file.Close()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+import "os"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// PrepareSignature saves PDF-document with an empty signature placeholder and returns the digest to sign
+	prepared, err := pdf.PrepareSignature(&asposepdf.SignOptions{Reason: "Approved"}, "sample_PrepareSignature.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Digest to sign (%v): %x\n", prepared.Hash, prepared.Digest)
+
+	// The CMS signature is produced externally, e.g. by an HSM
+	cms, err := os.ReadFile("signature.p7s")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Inject(cms []byte) writes the CMS signature into the placeholder
+	err = prepared.Inject(cms)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,30 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// Revisions() returns the list of revisions of PDF-document
+	revisions, err := pdf.Revisions()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, revision := range revisions {
+		fmt.Printf("Revision %d: %d bytes, signatures %v\n", revision.Number, revision.Size, revision.Signatures)
+	}
+	// ExtractRevision(num int32, filename string) saves the specified revision as a separate PDF-document
+	err = pdf.ExtractRevision(1, "sample_Revision1.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,26 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// SaveHtml(filename string, options *HtmlOptions) saves previously opened PDF-document as HTML with filename
+	err = pdf.SaveHtml("sample.html", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Split pages with external images and CSS into a ZIP-archive
+	err = pdf.SaveHtml("sample_html.zip", &asposepdf.HtmlOptions{SplitPages: true, ExternalImages: true, ExternalCss: true})
+	if err != nil {
+		log.Fatal(err)
+	}
+}
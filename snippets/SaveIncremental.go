@@ -0,0 +1,26 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// PageAddText(num int32, addText string) adds text on page
+	err = pdf.PageAddText(1, "Approved")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// SaveIncremental() appends changes as a new revision without rewriting the file
+	err = pdf.SaveIncremental()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
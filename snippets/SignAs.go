@@ -0,0 +1,38 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "crypto"
+import "log"
+import "os"
+
+func main() {
+	cert, _ := os.ReadFile("sign.pfx")
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// SignAs signs a PDF-document with a PKCS#12 certificate and saves it with filename
+	err = pdf.SignAs(cert, "Pa$$w0rd2023", &asposepdf.SignOptions{
+		FieldName:      "Approval",
+		Pages:          []int32{1, 2},
+		X:              100,
+		Y:              100,
+		Width:          200,
+		Height:         70,
+		Reason:         "Reason",
+		Visible:        true,
+		AppearanceText: "Signed by {signer}\n{date}",
+		Hash:           crypto.SHA384,
+		Certification:  asposepdf.CertifiedFormFilling,
+	}, "sample_SignAs.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
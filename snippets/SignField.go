@@ -0,0 +1,35 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+import "os"
+
+func main() {
+	cert, _ := os.ReadFile("sign.pfx")
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample_AddSignatureField.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// UnsignedSignatureFields returns signature fields waiting to be signed
+	fields, err := pdf.UnsignedSignatureFields()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, field := range fields {
+		fmt.Printf("%s on page %d\n", field.Name, field.Page)
+	}
+
+	// SignField signs the existing empty signature field
+	err = pdf.SignField("Seller", cert, "Pa$$w0rd2023", &asposepdf.SignOptions{Reason: "Agreed"}, "sample_SignField.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
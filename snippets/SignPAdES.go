@@ -0,0 +1,48 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "crypto/tls"
+import "crypto"
+import "crypto/x509"
+import "log"
+import "os"
+
+func main() {
+	pair, err := tls.LoadX509KeyPair("sign.crt", "sign.key")
+	if err != nil {
+		log.Fatal(err)
+	}
+	chain := make([]*x509.Certificate, 0, len(pair.Certificate))
+	for _, der := range pair.Certificate {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			log.Fatal(err)
+		}
+		chain = append(chain, cert)
+	}
+	// Revocation data of the chain, stored in the DSS dictionary for long-term validation
+	crl, err := os.ReadFile("sign.crl")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// SignPAdES signs a PDF-document with a PAdES baseline signature
+	err = pdf.SignPAdES(pair.PrivateKey.(crypto.Signer), chain, &asposepdf.SignOptions{Reason: "Approved"}, &asposepdf.PAdESOptions{
+		Profile:        asposepdf.PAdES_B_LTA,
+		TSA:            &asposepdf.HTTPTimestampClient{URL: "http://timestamp.example.com"},
+		ValidationData: asposepdf.ValidationData{CRLs: [][]byte{crl}},
+	}, "sample_SignPAdES.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,31 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "log"
+import "os"
+
+func main() {
+	cert, _ := os.ReadFile("sign.pfx")
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	out, err := os.Create("sample_SignTo.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer out.Close()
+
+	// SignTo signs a PDF-document with a PKCS#12 certificate and writes it to io.Writer
+	err = pdf.SignTo(cert, "Pa$$w0rd2023", &asposepdf.SignOptions{Reason: "Reason"}, out)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
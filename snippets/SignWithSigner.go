@@ -0,0 +1,39 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "crypto/tls"
+import "crypto"
+import "crypto/x509"
+import "log"
+
+func main() {
+	// Any crypto.Signer can be used, e.g. a key kept in an HSM or KMS
+	pair, err := tls.LoadX509KeyPair("sign.crt", "sign.key")
+	if err != nil {
+		log.Fatal(err)
+	}
+	chain := make([]*x509.Certificate, 0, len(pair.Certificate))
+	for _, der := range pair.Certificate {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			log.Fatal(err)
+		}
+		chain = append(chain, cert)
+	}
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// SignWithSigner signs a PDF-document with a crypto.Signer
+	err = pdf.SignWithSigner(pair.PrivateKey.(crypto.Signer), chain, &asposepdf.SignOptions{Reason: "Approved", Location: "London"}, "sample_SignWithSigner.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
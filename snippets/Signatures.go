@@ -0,0 +1,43 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "crypto/x509"
+import "fmt"
+import "log"
+import "os"
+
+func main() {
+	root, _ := os.ReadFile("root.cer")
+	rootCert, err := x509.ParseCertificate(root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(rootCert)
+	crl, _ := os.ReadFile("root.crl")
+
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample_SignPKCS7.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// Signatures() returns information about digital signatures of PDF-document
+	signatures, err := pdf.Signatures()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, signature := range signatures {
+		fmt.Printf("%s: signed by %s at %v, whole document: %v\n", signature.Name, signature.SignerName, signature.SigningTime, signature.CoversWholeDocument)
+		// Verify(options *VerifyOptions) checks integrity and validates the certificate chain offline
+		err = signature.Verify(&asposepdf.VerifyOptions{Roots: roots, CRLs: [][]byte{crl}})
+		if err != nil {
+			fmt.Println("Verification failed:", err)
+		} else {
+			fmt.Println("Signature is valid")
+		}
+	}
+}
@@ -0,0 +1,39 @@
+//go:build asposepdf_unreleased
+
+package main
+
+import "github.com/aspose-pdf/aspose-pdf-go-cpp"
+import "fmt"
+import "log"
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+	// StructureTree() returns the root of the logical structure tree of a tagged PDF-document
+	root, err := pdf.StructureTree()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, heading := range root.Headings() {
+		fmt.Printf("%s: %s\n", heading.Type, heading.Text)
+	}
+	for _, figure := range root.Elements("Figure") {
+		if figure.AltText == "" {
+			// SetAltText(id string, altText string) sets the alternate description of the structure element with id
+			err = pdf.SetAltText(figure.ID, fmt.Sprintf("Figure on page %d", figure.Page))
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	// SaveAs(filename string) saves previously opened PDF-document with new filename
+	err = pdf.SaveAs("sample_StructureTree.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"github.com/aspose-pdf/aspose-pdf-go-cpp"
+	"log"
+)
+
+func main() {
+	// Open(filename string) opens a PDF-document with filename
+	pdf, err := asposepdf.Open("sample.pdf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Close() releases allocated resources for PDF-document
+	defer pdf.Close()
+
+	// ValidateWithReport(pdfFormat PdfFormat) validates PDF-document and returns a structured report
+	report, err := pdf.ValidateWithReport(asposepdf.PDF_A_2A)
+	if err != nil {
+		log.Fatal("Validate PDF/A error:", err)
+	}
+
+	// Print validation result, issues and counts by category
+	fmt.Printf("Validate PDF/A result: %v, %d errors, %d warnings\n", report.Valid, report.Errors, report.Warnings)
+	for _, issue := range report.Issues {
+		fmt.Printf("%s %s page %d: %s\n", issue.Severity, issue.Clause, issue.Page, issue.Message)
+	}
+	for category, count := range report.Categories {
+		fmt.Printf("%s: %d\n", category, count)
+	}
+}
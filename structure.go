@@ -0,0 +1,158 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotTagged is returned by StructureTree if PDF-document has no logical structure.
+var ErrNotTagged = errors.New("PDF-document is not tagged")
+
+// StructureElement is an element of the logical structure tree of a tagged PDF-document.
+type StructureElement struct {
+	ID       string             `json:"id"`       // Identifier of the element for SetAltText
+	Type     string             `json:"type"`     // Standard structure type, e.g. Document, H1, P, L, LI, Table, TR, TD, Figure
+	Text     string             `json:"text"`     // Text content of the element and its descendants, or its ActualText
+	AltText  string             `json:"alttext"`  // Alternate description, required for figures by PDF/UA
+	Language string             `json:"language"` // Language of the element, e.g. en-US; empty if inherited
+	Page     int32              `json:"page"`     // Page of the first content of the element, 0 if the element has no content
+	Rect     *Rectangle         `json:"rect"`     // Bounding box of the content on Page, nil if the element has no content
+	Children []StructureElement `json:"children"` // Child elements in reading order
+}
+
+// Walk calls fn for the element and its descendants in reading order.
+// The children of an element are skipped if fn returns false.
+func (element *StructureElement) Walk(fn func(e *StructureElement) bool) {
+	if !fn(element) {
+		return
+	}
+	for i := range element.Children {
+		element.Children[i].Walk(fn)
+	}
+}
+
+// Elements returns the element and its descendants of the given structure types in reading order,
+// e.g. Elements("Figure") or Elements("H1", "H2"); all elements if no types are given.
+func (element *StructureElement) Elements(types ...string) []*StructureElement {
+	var elements []*StructureElement
+	element.Walk(func(e *StructureElement) bool {
+		if len(types) == 0 {
+			elements = append(elements, e)
+			return true
+		}
+		for _, t := range types {
+			if e.Type == t {
+				elements = append(elements, e)
+				break
+			}
+		}
+		return true
+	})
+	return elements
+}
+
+// Headings returns the heading elements H and H1 to H6 in reading order.
+func (element *StructureElement) Headings() []*StructureElement {
+	return element.Elements("H", "H1", "H2", "H3", "H4", "H5", "H6")
+}
+
+// ReadingOrder returns the elements without children, i.e. the content of the document, in reading order.
+func (element *StructureElement) ReadingOrder() []*StructureElement {
+	var elements []*StructureElement
+	element.Walk(func(e *StructureElement) bool {
+		if len(e.Children) == 0 {
+			elements = append(elements, e)
+		}
+		return true
+	})
+	return elements
+}
+
+// validLanguage reports whether language is a well-formed BCP 47 language tag like en, en-US or zh-Hant-TW.
+func validLanguage(language string) bool {
+	subtags := strings.Split(language, "-")
+	for i, subtag := range subtags {
+		if len(subtag) == 0 || len(subtag) > 8 {
+			return false
+		}
+		for _, r := range subtag {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && r >= '0' && r <= '9') {
+				return false
+			}
+		}
+	}
+	primary := subtags[0]
+	return len(primary) >= 2 && len(primary) <= 3 || strings.EqualFold(primary, "x") || strings.EqualFold(primary, "i")
+}
+
+// IsTagged gets is a PDF-document tagged, i.e. has a logical structure tree.
+//
+// Example:
+//
+//	tagged, err := pdf.IsTagged()
+func (document *Document) IsTagged() (bool, error) {
+	return document.isTagged()
+}
+
+// StructureTree returns the root of the logical structure tree of a tagged PDF-document.
+// ErrNotTagged is returned if PDF-document is not tagged.
+//
+// Example:
+//
+//	root, err := pdf.StructureTree()
+//	for _, figure := range root.Elements("Figure") {
+//		fmt.Printf("page %d: %q\n", figure.Page, figure.AltText)
+//	}
+func (document *Document) StructureTree() (*StructureElement, error) {
+	return document.structureTree()
+}
+
+// SetAltText sets the alternate description of the structure element with id, e.g. of a Figure.
+//
+// Example:
+//
+//	err := pdf.SetAltText(figure.ID, "Revenue by quarter, 2026")
+func (document *Document) SetAltText(id string, altText string) error {
+	if strings.TrimSpace(altText) == "" {
+		return errors.New("SetAltText(): alternate text is empty")
+	}
+	return document.setAltText(id, altText)
+}
+
+// SetLanguage sets the natural language of PDF-document as a BCP 47 tag, e.g. en-US.
+//
+// Example:
+//
+//	err := pdf.SetLanguage("de-DE")
+func (document *Document) SetLanguage(language string) error {
+	if !validLanguage(language) {
+		return fmt.Errorf("SetLanguage(): invalid language tag %q", language)
+	}
+	return document.setLanguage(language)
+}
+
+// SetTitle sets the title of PDF-document in the document information and XMP metadata
+// and makes viewers display it instead of the file name, as required by PDF/UA.
+//
+// Example:
+//
+//	err := pdf.SetTitle("Annual report 2026")
+func (document *Document) SetTitle(title string) error {
+	if strings.TrimSpace(title) == "" {
+		return errors.New("SetTitle(): title is empty")
+	}
+	return document.setTitle(title)
+}
+
+// AutoTag creates the logical structure tree of an untagged PDF-document from its layout:
+// headings, paragraphs, lists, tables and figures in reading order. Tagged documents are left unchanged.
+//
+// Example:
+//
+//	err := pdf.AutoTag()
+func (document *Document) AutoTag() error {
+	return document.autoTag()
+}
@@ -0,0 +1,104 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// TableCell is a cell of a table found on a page.
+type TableCell struct {
+	Column     int32     `json:"column"`     // Index of the first column of the cell, starting from 0
+	RowSpan    int32     `json:"rowspan"`    // Number of rows the cell spans, at least 1
+	ColumnSpan int32     `json:"columnspan"` // Number of columns the cell spans, at least 1
+	Text       string    `json:"text"`       // Text of the cell, lines joined with \n
+	Rect       Rectangle `json:"rect"`       // Bounding box of the cell
+}
+
+// TableRow is a row of a table found on a page.
+type TableRow struct {
+	Cells []TableCell `json:"cells"` // Cells starting in the row, left to right
+	Rect  Rectangle   `json:"rect"`  // Bounding box of the row
+}
+
+// Table is a table found on a page by PageTables.
+type Table struct {
+	Page int32      `json:"page"` // Page number
+	Rect Rectangle  `json:"rect"` // Bounding box of the table
+	Rows []TableRow `json:"rows"` // Rows top to bottom
+}
+
+// span returns n, or 1 if n is not positive.
+func span(n int32) int {
+	if n < 1 {
+		return 1
+	}
+	return int(n)
+}
+
+// Columns returns the number of columns of the table.
+func (table *Table) Columns() int {
+	columns := 0
+	for _, row := range table.Rows {
+		for _, cell := range row.Cells {
+			columns = max(columns, int(cell.Column)+span(cell.ColumnSpan))
+		}
+	}
+	return columns
+}
+
+// Strings returns the text of the table as a grid of rows and columns.
+// The text of a spanning cell is in its top left position, the other positions it covers are empty.
+//
+// Example:
+//
+//	tables, err := pdf.PageTables(1)
+//	for _, row := range tables[0].Strings() {
+//		fmt.Println(strings.Join(row, " | "))
+//	}
+func (table *Table) Strings() [][]string {
+	rows := len(table.Rows)
+	for i, row := range table.Rows {
+		for _, cell := range row.Cells {
+			rows = max(rows, i+span(cell.RowSpan))
+		}
+	}
+	columns := table.Columns()
+	grid := make([][]string, rows)
+	for i := range grid {
+		grid[i] = make([]string, columns)
+	}
+	for i, row := range table.Rows {
+		for _, cell := range row.Cells {
+			if cell.Column >= 0 {
+				grid[i][cell.Column] = cell.Text
+			}
+		}
+	}
+	return grid
+}
+
+// WriteCSV writes the text of the table to w as CSV, see Strings for spanning cells.
+//
+// Example:
+//
+//	file, _ := os.Create("table.csv")
+//	defer file.Close()
+//	err := tables[0].WriteCSV(file)
+func (table *Table) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(table.Strings()); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// PageTables returns the tables found on page with their rows, cells, text and bounding boxes.
+//
+// Example:
+//
+//	tables, err := pdf.PageTables(1)
+func (document *Document) PageTables(num int32) ([]Table, error) {
+	return document.pageTables(num)
+}
@@ -0,0 +1,374 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+// TimestampClient obtains RFC 3161 timestamp tokens from a time stamping authority (TSA).
+//
+// Implementations return the DER-encoded TimeStampToken for the digest
+// after verifying the TSA signature and certificate, as HTTPTimestampClient does.
+type TimestampClient interface {
+	Timestamp(digest []byte, hash crypto.Hash) ([]byte, error)
+}
+
+// HTTPTimestampClient requests timestamp tokens from a TSA over HTTP as described in RFC 3161.
+type HTTPTimestampClient struct {
+	URL      string         // URL of the TSA
+	Client   *http.Client   // HTTP client; a client with a 30 seconds timeout if nil
+	Roots    *x509.CertPool // Trusted root certificates of the TSA; the system pool is used if nil
+	Username string         // Optional user name for basic authentication
+	Password string         // Optional password for basic authentication
+}
+
+// Maximum size of a TSA response.
+const maxTimestampResponseSize = 1 << 20
+
+// defaultHTTPClient is used by HTTPTimestampClient and HTTPRevocationClient without a Client.
+var defaultHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+type tsMessageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type tsRequest struct {
+	Version        int
+	MessageImprint tsMessageImprint
+	Nonce          *big.Int `asn1:"optional"`
+	CertReq        bool     `asn1:"optional,default:false"`
+}
+
+type tsResponse struct {
+	Status         tsStatus
+	TimeStampToken asn1.RawValue `asn1:"optional"`
+}
+
+type tsStatus struct {
+	Status       int
+	StatusString []string       `asn1:"optional,utf8"`
+	FailInfo     asn1.BitString `asn1:"optional"`
+}
+
+type tsAccuracy struct {
+	Seconds int `asn1:"optional"`
+	Millis  int `asn1:"optional,tag:0"`
+	Micros  int `asn1:"optional,tag:1"`
+}
+
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint tsMessageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time  `asn1:"generalized"`
+	Accuracy       tsAccuracy `asn1:"optional"`
+	Ordering       bool       `asn1:"optional,default:false"`
+	Nonce          *big.Int   `asn1:"optional"`
+}
+
+type tsSignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	EncapContentInfo cmsEncapContentInfo
+	Certificates     asn1.RawValue  `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue  `asn1:"optional,tag:1"`
+	SignerInfos      []tsSignerInfo `asn1:"set"`
+}
+
+type tsSignerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type tsAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue
+}
+
+type tsContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     struct {
+		Version          int
+		DigestAlgorithms asn1.RawValue
+		EncapContentInfo cmsEncapContentInfo
+	} `asn1:"explicit,tag:0"`
+}
+
+// Timestamp sends a timestamp request for the digest and returns the DER-encoded TimeStampToken.
+func (client *HTTPTimestampClient) Timestamp(digest []byte, hash crypto.Hash) ([]byte, error) {
+	digestOID, ok := cmsDigestAlgorithms[hash]
+	if !ok {
+		return nil, fmt.Errorf("unsupported hash algorithm %v", hash)
+	}
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, err
+	}
+	request, err := asn1.Marshal(tsRequest{
+		Version: 1,
+		MessageImprint: tsMessageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: digestOID, Parameters: asn1.NullRawValue},
+			HashedMessage: digest,
+		},
+		Nonce:   nonce,
+		CertReq: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest, err := http.NewRequest(http.MethodPost, client.URL, bytes.NewReader(request))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header.Set("Content-Type", "application/timestamp-query")
+	if client.Username != "" {
+		httpRequest.SetBasicAuth(client.Username, client.Password)
+	}
+	httpClient := client.Client
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("TSA responded with %s", httpResponse.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(httpResponse.Body, maxTimestampResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var response tsResponse
+	if _, err := asn1.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse TSA response: %w", err)
+	}
+	// 0 - granted, 1 - granted with modifications
+	if response.Status.Status > 1 {
+		return nil, fmt.Errorf("TSA rejected the request with status %d %v", response.Status.Status, response.Status.StatusString)
+	}
+	token := response.TimeStampToken.FullBytes
+	info, err := parseTimestampToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if info.Nonce == nil || info.Nonce.Cmp(nonce) != 0 {
+		return nil, errors.New("timestamp token nonce does not match the request")
+	}
+	if _, err := verifyTimestampToken(token, digest, hash, client.Roots); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// parseTimestampToken returns the TSTInfo encapsulated in the TimeStampToken.
+func parseTimestampToken(token []byte) (*tstInfo, error) {
+	var content tsContentInfo
+	if _, err := asn1.Unmarshal(token, &content); err != nil {
+		return nil, fmt.Errorf("failed to parse timestamp token: %w", err)
+	}
+	if !content.ContentType.Equal(oidSignedData) || !content.Content.EncapContentInfo.EContentType.Equal(oidTSTInfo) {
+		return nil, errors.New("timestamp token is not a signed TSTInfo")
+	}
+	var info tstInfo
+	if _, err := asn1.Unmarshal(content.Content.EncapContentInfo.EContent, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse TSTInfo: %w", err)
+	}
+	return &info, nil
+}
+
+// verifyTimestampToken checks that token timestamps digest computed with hash and is signed by a TSA
+// certificate chaining to roots (the system pool if nil) at the time of the timestamp.
+// Returns the verified chain, the TSA certificate first.
+func verifyTimestampToken(token []byte, digest []byte, hash crypto.Hash, roots *x509.CertPool) ([]*x509.Certificate, error) {
+	info, err := parseTimestampToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if digestOID, ok := cmsDigestAlgorithms[hash]; !ok || !info.MessageImprint.HashAlgorithm.Algorithm.Equal(digestOID) {
+		return nil, errors.New("timestamp token hash algorithm does not match the request")
+	}
+	if !bytes.Equal(info.MessageImprint.HashedMessage, digest) {
+		return nil, errors.New("timestamp token does not match the digest")
+	}
+
+	signedData, certs, err := parseTimestampSignedData(token)
+	if err != nil {
+		return nil, err
+	}
+	if len(signedData.SignerInfos) != 1 {
+		return nil, fmt.Errorf("timestamp token has %d signers", len(signedData.SignerInfos))
+	}
+	signerInfo := &signedData.SignerInfos[0]
+	tsa := timestampSigner(signerInfo, certs)
+	if tsa == nil {
+		return nil, errors.New("timestamp token does not contain the TSA certificate")
+	}
+	if err := signerInfo.verify(tsa, signedData.EncapContentInfo.EContent); err != nil {
+		return nil, fmt.Errorf("invalid timestamp token signature: %w", err)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs {
+		intermediates.AddCert(cert)
+	}
+	chains, err := tsa.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   info.GenTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("untrusted TSA certificate: %w", err)
+	}
+	return chains[0], nil
+}
+
+// timestampChain returns the TSA certificate of token followed by its issuers found in token, without verifying them.
+func timestampChain(token []byte) ([]*x509.Certificate, error) {
+	signedData, certs, err := parseTimestampSignedData(token)
+	if err != nil {
+		return nil, err
+	}
+	if len(signedData.SignerInfos) != 1 {
+		return nil, fmt.Errorf("timestamp token has %d signers", len(signedData.SignerInfos))
+	}
+	cert := timestampSigner(&signedData.SignerInfos[0], certs)
+	if cert == nil {
+		return nil, errors.New("timestamp token does not contain the TSA certificate")
+	}
+	chain := []*x509.Certificate{cert}
+	for len(chain) <= len(certs) && !bytes.Equal(cert.RawIssuer, cert.RawSubject) {
+		var issuer *x509.Certificate
+		for _, candidate := range certs {
+			if bytes.Equal(candidate.RawSubject, cert.RawIssuer) && cert.CheckSignatureFrom(candidate) == nil {
+				issuer = candidate
+				break
+			}
+		}
+		if issuer == nil {
+			break
+		}
+		chain = append(chain, issuer)
+		cert = issuer
+	}
+	return chain, nil
+}
+
+// parseTimestampSignedData returns the SignedData of the TimeStampToken and its certificates.
+func parseTimestampSignedData(token []byte) (*tsSignedData, []*x509.Certificate, error) {
+	var content cmsContentInfo
+	if _, err := asn1.Unmarshal(token, &content); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse timestamp token: %w", err)
+	}
+	var signedData tsSignedData
+	if _, err := asn1.Unmarshal(content.Content.Bytes, &signedData); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse timestamp token: %w", err)
+	}
+	certs, err := x509.ParseCertificates(signedData.Certificates.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse timestamp token certificates: %w", err)
+	}
+	return &signedData, certs, nil
+}
+
+// timestampSigner returns the certificate identified by the signer identifier, or nil.
+func timestampSigner(signerInfo *tsSignerInfo, certs []*x509.Certificate) *x509.Certificate {
+	if signerInfo.SID.Class == asn1.ClassContextSpecific && signerInfo.SID.Tag == 0 {
+		for _, cert := range certs {
+			if bytes.Equal(cert.SubjectKeyId, signerInfo.SID.Bytes) {
+				return cert
+			}
+		}
+		return nil
+	}
+	var sid cmsIssuerAndSerial
+	if _, err := asn1.Unmarshal(signerInfo.SID.FullBytes, &sid); err != nil {
+		return nil
+	}
+	for _, cert := range certs {
+		if bytes.Equal(cert.RawIssuer, sid.Issuer.FullBytes) && cert.SerialNumber.Cmp(sid.SerialNumber) == 0 {
+			return cert
+		}
+	}
+	return nil
+}
+
+// verify checks the signed attributes against content and their signature with the public key of cert.
+func (signerInfo *tsSignerInfo) verify(cert *x509.Certificate, content []byte) error {
+	hash, ok := ocspHashes[signerInfo.DigestAlgorithm.Algorithm.String()]
+	if !ok || !hash.Available() {
+		return fmt.Errorf("unsupported digest algorithm %v", signerInfo.DigestAlgorithm.Algorithm)
+	}
+	if len(signerInfo.SignedAttrs.FullBytes) == 0 {
+		return errors.New("signed attributes are missing")
+	}
+
+	var contentType asn1.ObjectIdentifier
+	var messageDigest []byte
+	for rest := signerInfo.SignedAttrs.Bytes; len(rest) > 0; {
+		var attr tsAttribute
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &attr); err != nil {
+			return err
+		}
+		switch {
+		case attr.Type.Equal(oidAttrContentType):
+			_, err = asn1.Unmarshal(attr.Values.Bytes, &contentType)
+		case attr.Type.Equal(oidAttrMessageDigest):
+			_, err = asn1.Unmarshal(attr.Values.Bytes, &messageDigest)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !contentType.Equal(oidTSTInfo) {
+		return errors.New("content type attribute is not TSTInfo")
+	}
+	h := hash.New()
+	h.Write(content)
+	if !bytes.Equal(messageDigest, h.Sum(nil)) {
+		return errors.New("message digest attribute does not match TSTInfo")
+	}
+
+	algorithm, ok := ocspSignatureAlgorithms[signerInfo.SignatureAlgorithm.Algorithm.String()]
+	if signerInfo.SignatureAlgorithm.Algorithm.Equal(oidRSAEncryption) {
+		algorithm, ok = rsaSignatureAlgorithms[hash]
+	}
+	if !ok {
+		return fmt.Errorf("unsupported signature algorithm %v", signerInfo.SignatureAlgorithm.Algorithm)
+	}
+	// The signature is computed over the DER encoding of the attributes as a SET OF.
+	signed := append([]byte{0x31}, signerInfo.SignedAttrs.FullBytes[1:]...)
+	return cert.CheckSignature(algorithm, signed, signerInfo.Signature)
+}
+
+// rsaSignatureAlgorithms maps the digest of a signature with the generic rsaEncryption algorithm identifier.
+var rsaSignatureAlgorithms = map[crypto.Hash]x509.SignatureAlgorithm{
+	crypto.SHA1:   x509.SHA1WithRSA,
+	crypto.SHA256: x509.SHA256WithRSA,
+	crypto.SHA384: x509.SHA384WithRSA,
+	crypto.SHA512: x509.SHA512WithRSA,
+}
@@ -0,0 +1,784 @@
+//go:build asposepdf_unreleased
+
+// Wrappers of native functions that the pinned native library (verLibsTag in unzip.go) does not export yet.
+// They are built with -tags asposepdf_unreleased against a native library exporting them,
+// together with the functions using them; Inspect uses the stubs in unreleased_stub.go otherwise.
+
+package asposepdf
+
+/*
+#include <stdlib.h>
+#include "extern_c.h"
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"unsafe"
+)
+
+// unreleased reports whether the wrappers of unreleased native functions are built.
+const unreleased = true
+
+func newMerger(filename string, options *MergeOptions) (*merger, error) {
+	if options == nil {
+		options = &MergeOptions{}
+	}
+	options_json, e := json.Marshal(options)
+	if e != nil {
+		return nil, e
+	}
+	runtime.LockOSThread()
+	var err *C.char
+	_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(_filename))
+	_options := C.CString(string(options_json))
+	defer C.free(unsafe.Pointer(_options))
+	m := C.PDFMerger_New(_filename, _options, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if m == nil {
+		runtime.UnlockOSThread()
+		return nil, errors.New(err_str)
+	}
+	return &merger{m}, nil
+}
+
+func (merger *merger) appendFile(filename string, title string) error {
+	var err *C.char
+	_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(_filename))
+	_title := C.CString(title)
+	defer C.free(unsafe.Pointer(_title))
+	C.PDFMerger_Append_File(merger.m, _filename, _title, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (merger *merger) appendMemory(data []byte, title string) error {
+	if len(data) == 0 {
+		return errors.New("empty input")
+	}
+	var err *C.char
+	_title := C.CString(title)
+	defer C.free(unsafe.Pointer(_title))
+	C.PDFMerger_Append_Memory(merger.m, (*C.uint8_t)(unsafe.Pointer(&data[0])), C.int(len(data)), _title, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+// close finalizes the merged PDF-document and releases the merger.
+func (merger *merger) close() error {
+	var err *C.char
+	C.PDFMerger_Close(merger.m, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		merger.release()
+		return errors.New(err_str)
+	}
+	return merger.release()
+}
+
+// release discards the merger without finalizing the merged PDF-document.
+func (merger *merger) release() error {
+	defer runtime.UnlockOSThread()
+	var err *C.char
+	C.PDFMerger_Release(merger.m, &err)
+	merger.m = nil
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) saveIncremental() error {
+	var err *C.char
+	C.PDFDocument_Save_Incremental(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) saveAsIncremental(filename string) error {
+	var err *C.char
+	_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(_filename))
+	C.PDFDocument_Save_As_Incremental(document.pdf, _filename, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) revisions() ([]RevisionInfo, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_Revisions(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var revisions []RevisionInfo
+	if e := json.Unmarshal([]byte(goJSON), &revisions); e != nil {
+		return nil, e
+	}
+	return revisions, nil
+}
+
+func (document *Document) extractRevision(num int32, filename string) error {
+	var err *C.char
+	_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(_filename))
+	C.PDFDocument_ExtractRevision(document.pdf, C.int(num), _filename, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) signatures() ([]*SignatureInfo, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_Signatures(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var signatures []*SignatureInfo
+	if e := json.Unmarshal([]byte(goJSON), &signatures); e != nil {
+		return nil, e
+	}
+	for _, signature := range signatures {
+		if e := signature.parseCertificates(); e != nil {
+			return nil, e
+		}
+		signature.document = document
+	}
+	return signatures, nil
+}
+
+// verifySignature checks that the signed byte range of the named signature has not been modified.
+func (document *Document) verifySignature(name string) (bool, error) {
+	var err *C.char
+	_name := C.CString(name)
+	defer C.free(unsafe.Pointer(_name))
+	valid_int := C.PDFDocument_VerifySignature(document.pdf, _name, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return false, errors.New(err_str)
+	} else {
+		return valid_int != 0, nil
+	}
+}
+
+// prepareSignature saves PDF-document with filename and an empty signature placeholder of subFilter type.
+// Returns the byte range of the file to be signed.
+func (document *Document) prepareSignature(options *SignOptions, subFilter string, filename string) ([]int64, error) {
+	options_json, e := options.marshal(subFilter)
+	if e != nil {
+		return nil, e
+	}
+	var err *C.char
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(_filename))
+	jsonStr := C.PDFDocument_PrepareSignature(document.pdf, _options, _filename, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var byteRange []int64
+	if e := json.Unmarshal([]byte(goJSON), &byteRange); e != nil {
+		return nil, e
+	}
+	return byteRange, nil
+}
+
+func (document *Document) addValidationData(data *ValidationData) error {
+	data_json, e := json.Marshal(data)
+	if e != nil {
+		return e
+	}
+	var err *C.char
+	_data := C.CString(string(data_json))
+	defer C.free(unsafe.Pointer(_data))
+	C.PDFDocument_AddValidationData(document.pdf, _data, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+// sign signs PDF-document with a detached signature and saves it with filename.
+// A nil filename passes NULL to the native library, which saves PDF-document in place
+// as an incremental update to the file it was opened from, as PDFDocument_Save does.
+func (document *Document) sign(signData []byte, pswSign string, options *SignOptions, filename *string) error {
+	options_json, e := options.marshal(subFilterPKCS7Detached)
+	if e != nil {
+		return e
+	}
+	var err *C.char
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	_pswSign := C.CString(pswSign)
+	defer C.free(unsafe.Pointer(_pswSign))
+	var _filename *C.char
+	if filename != nil {
+		_filename = C.CString(*filename)
+		defer C.free(unsafe.Pointer(_filename))
+	}
+	var _signDataPtr *C.uint8_t
+	if len(signData) > 0 {
+		_signDataPtr = (*C.uint8_t)(unsafe.Pointer(&signData[0]))
+	}
+	C.PDFDocument_Sign(document.pdf, _signDataPtr, C.int(len(signData)), _pswSign, _options, _filename, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) signTo(signData []byte, pswSign string, options *SignOptions, w io.Writer) error {
+	options_json, e := options.marshal(subFilterPKCS7Detached)
+	if e != nil {
+		return e
+	}
+	var err *C.char
+	var buf *C.uchar
+	var size C.int
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	_pswSign := C.CString(pswSign)
+	defer C.free(unsafe.Pointer(_pswSign))
+	var _signDataPtr *C.uint8_t
+	if len(signData) > 0 {
+		_signDataPtr = (*C.uint8_t)(unsafe.Pointer(&signData[0]))
+	}
+	C.PDFDocument_Sign_Memory(document.pdf, _signDataPtr, C.int(len(signData)), _pswSign, _options, &buf, &size, &err)
+	defer C.c_free_string(err)
+
+	err_str := C.GoString(err)
+	if err_str != "" || buf == nil || size == 0 {
+		return fmt.Errorf("failed to sign PDF-document: %s", err_str)
+	}
+
+	defer C.c_free_buffer(unsafe.Pointer(buf))
+	_, e = w.Write(C.GoBytes(unsafe.Pointer(buf), size))
+	return e
+}
+
+func (document *Document) addSignatureField(num int32, name string, options_json string) error {
+	var err *C.char
+	_name := C.CString(name)
+	defer C.free(unsafe.Pointer(_name))
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	C.PDFDocument_AddSignatureField(document.pdf, C.int(num), _name, _options, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) signatureFields() ([]SignatureFieldInfo, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_SignatureFields(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var fields []SignatureFieldInfo
+	if e := json.Unmarshal([]byte(goJSON), &fields); e != nil {
+		return nil, e
+	}
+	return fields, nil
+}
+
+// openWithCertificate opens PDF-document with the DER-encoded certificate and PKCS#8 private key.
+// The OS thread is unlocked again if the document cannot be opened.
+func openWithCertificate(filename string, certData []byte, keyData []byte) (*Document, error) {
+	runtime.LockOSThread()
+	var err *C.char
+	_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(_filename))
+	_certDataPtr := (*C.uint8_t)(unsafe.Pointer(&certData[0]))
+	_keyDataPtr := (*C.uint8_t)(unsafe.Pointer(&keyData[0]))
+	doc := C.PDFDocument_Open_With_Certificate(_filename, _certDataPtr, C.int(len(certData)), _keyDataPtr, C.int(len(keyData)), &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if doc != nil {
+		return &Document{doc}, nil
+	} else {
+		runtime.UnlockOSThread()
+		return &Document{nil}, errors.New(err_str)
+	}
+}
+
+func (document *Document) encryptForRecipients(recipients_json string, cryptoAlgorithm CryptoAlgorithm) error {
+	var err *C.char
+	_recipients := C.CString(recipients_json)
+	defer C.free(unsafe.Pointer(_recipients))
+	C.PDFDocument_Encrypt_PubSec(document.pdf, _recipients, C.int(cryptoAlgorithm), &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) encryptionInfo() (*EncryptionInfo, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_EncryptionInfo(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var info EncryptionInfo
+	if e := json.Unmarshal([]byte(goJSON), &info); e != nil {
+		return nil, e
+	}
+	return &info, nil
+}
+
+func (document *Document) fonts() ([]FontInfo, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_Fonts(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var fonts []FontInfo
+	if e := json.Unmarshal([]byte(goJSON), &fonts); e != nil {
+		return nil, e
+	}
+	return fonts, nil
+}
+
+// Font sources are global native state, changed on a locked OS thread like the documents.
+
+func addFontDirectory(dir string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var err *C.char
+	_dir := C.CString(dir)
+	defer C.free(unsafe.Pointer(_dir))
+	C.PDFFonts_AddDirectory(_dir, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func addFontData(data []byte) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var err *C.char
+	C.PDFFonts_AddMemory((*C.uint8_t)(unsafe.Pointer(&data[0])), C.int(len(data)), &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func setFontSubstitution(fontName string, fallbacks_json string) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var err *C.char
+	_fontName := C.CString(fontName)
+	defer C.free(unsafe.Pointer(_fontName))
+	_fallbacks := C.CString(fallbacks_json)
+	defer C.free(unsafe.Pointer(_fallbacks))
+	C.PDFFonts_SetSubstitution(_fontName, _fallbacks, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func resetFontSources() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var err *C.char
+	C.PDFFonts_Reset(&err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) stampHeaderFooter(template_json string, isFooter bool) (int32, error) {
+	var err *C.char
+	_template := C.CString(template_json)
+	defer C.free(unsafe.Pointer(_template))
+	_isFooter := 0
+	if isFooter {
+		_isFooter = 1
+	}
+	stamped := C.PDFDocument_AddHeaderFooter(document.pdf, _template, C.int(_isFooter), &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return 0, errors.New(err_str)
+	}
+	return int32(stamped), nil
+}
+
+func (document *Document) addPageNumbers(options_json string) error {
+	var err *C.char
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	C.PDFDocument_AddPageNumbers(document.pdf, _options, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) pageLabels() ([]PageLabelRange, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_PageLabels(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var ranges []PageLabelRange
+	if e := json.Unmarshal([]byte(goJSON), &ranges); e != nil {
+		return nil, e
+	}
+	return ranges, nil
+}
+
+func (document *Document) setPageLabels(ranges_json string) error {
+	var err *C.char
+	_ranges := C.CString(ranges_json)
+	defer C.free(unsafe.Pointer(_ranges))
+	C.PDFDocument_set_PageLabels(document.pdf, _ranges, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) addWatermarkWithOptions(options_json string) error {
+	var err *C.char
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	C.PDFDocument_AddWatermark_Options(document.pdf, _options, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) properties() (*InspectionReport, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_Properties(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var report InspectionReport
+	if e := json.Unmarshal([]byte(goJSON), &report); e != nil {
+		return nil, e
+	}
+	return &report, nil
+}
+
+func (document *Document) attachments() ([]AttachmentInfo, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_Attachments(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var attachments []AttachmentInfo
+	if e := json.Unmarshal([]byte(goJSON), &attachments); e != nil {
+		return nil, e
+	}
+	return attachments, nil
+}
+
+func (document *Document) extractAttachment(name string, w io.Writer) error {
+	var err *C.char
+	var buf *C.uchar
+	var size C.int
+	_name := C.CString(name)
+	defer C.free(unsafe.Pointer(_name))
+	C.PDFDocument_get_Attachment(document.pdf, _name, &buf, &size, &err)
+	if buf != nil {
+		defer C.c_free_buffer(unsafe.Pointer(buf))
+	}
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	}
+	if size == 0 {
+		return nil
+	}
+	if _, e := w.Write(C.GoBytes(unsafe.Pointer(buf), size)); e != nil {
+		return fmt.Errorf("ExtractAttachment(): %w", e)
+	}
+	return nil
+}
+
+func (document *Document) addAttachment(data []byte, options_json string) error {
+	var err *C.char
+	var _dataPtr *C.uint8_t
+	if len(data) > 0 {
+		_dataPtr = (*C.uint8_t)(unsafe.Pointer(&data[0]))
+	}
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	C.PDFDocument_AddAttachment(document.pdf, _dataPtr, C.int(len(data)), _options, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) pageAddFileAttachment(num int32, data []byte, options_json string) error {
+	var err *C.char
+	var _dataPtr *C.uint8_t
+	if len(data) > 0 {
+		_dataPtr = (*C.uint8_t)(unsafe.Pointer(&data[0]))
+	}
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	C.PDFDocument_Page_AddFileAttachment(document.pdf, C.int(num), _dataPtr, C.int(len(data)), _options, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) addXmpMetadata(xmp string) error {
+	var err *C.char
+	_xmp := C.CString(xmp)
+	defer C.free(unsafe.Pointer(_xmp))
+	C.PDFDocument_AddXmpMetadata(document.pdf, _xmp, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) isTagged() (bool, error) {
+	var err *C.char
+	tagged_int := C.PDFDocument_is_Tagged(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return false, errors.New(err_str)
+	} else {
+		return tagged_int != 0, nil
+	}
+}
+
+func (document *Document) structureTree() (*StructureElement, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_get_StructureTree(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var root *StructureElement
+	if e := json.Unmarshal([]byte(goJSON), &root); e != nil {
+		return nil, e
+	}
+	if root == nil {
+		return nil, ErrNotTagged
+	}
+	return root, nil
+}
+
+func (document *Document) setAltText(id string, altText string) error {
+	var err *C.char
+	_id := C.CString(id)
+	defer C.free(unsafe.Pointer(_id))
+	_altText := C.CString(altText)
+	defer C.free(unsafe.Pointer(_altText))
+	C.PDFDocument_SetStructureAltText(document.pdf, _id, _altText, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) setLanguage(language string) error {
+	var err *C.char
+	_language := C.CString(language)
+	defer C.free(unsafe.Pointer(_language))
+	C.PDFDocument_set_Language(document.pdf, _language, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) setTitle(title string) error {
+	var err *C.char
+	_title := C.CString(title)
+	defer C.free(unsafe.Pointer(_title))
+	C.PDFDocument_set_Title(document.pdf, _title, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) autoTag() error {
+	var err *C.char
+	C.PDFDocument_AutoTag(document.pdf, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
+
+func (document *Document) pageTables(num int32) ([]Table, error) {
+	var err *C.char
+	jsonStr := C.PDFDocument_Page_get_Tables(document.pdf, C.int(num), &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return nil, errors.New(err_str)
+	}
+	defer C.c_free_string(jsonStr)
+	goJSON := C.GoString(jsonStr)
+	var tables []Table
+	if e := json.Unmarshal([]byte(goJSON), &tables); e != nil {
+		return nil, e
+	}
+	for i := range tables {
+		tables[i].Page = num
+	}
+	return tables, nil
+}
+
+func (document *Document) saveHtml(filename string, options_json string) error {
+	var err *C.char
+	_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(_filename))
+	_options := C.CString(options_json)
+	defer C.free(unsafe.Pointer(_options))
+	C.PDFDocument_Save_Html(document.pdf, _filename, _options, &err)
+	err_str := C.GoString(err)
+	C.c_free_string(err)
+	if err_str != ERR_OK {
+		return errors.New(err_str)
+	} else {
+		return nil
+	}
+}
@@ -0,0 +1,25 @@
+//go:build !asposepdf_unreleased
+
+// Stubs of the wrappers in unreleased.go for the pinned native library, which does not export their functions.
+
+package asposepdf
+
+import (
+	"fmt"
+)
+
+// unreleased reports whether the wrappers of unreleased native functions are built.
+const unreleased = false
+
+// notSupported returns errNotSupported for the native function name.
+func notSupported(name string) error {
+	return fmt.Errorf("%s: %w", name, errNotSupported)
+}
+
+func (document *Document) fonts() ([]FontInfo, error) {
+	return nil, notSupported("PDFDocument_get_Fonts")
+}
+
+func (document *Document) properties() (*InspectionReport, error) {
+	return nil, notSupported("PDFDocument_get_Properties")
+}
@@ -0,0 +1,1668 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSaveHtml(t *testing.T) {
+	for _, test := range []struct {
+		filename string
+		options  *HtmlOptions
+		output   string
+	}{
+		{"page.html", nil, `"output":"file"`},
+		{"site.ZIP", &HtmlOptions{SplitPages: true}, `"output":"zip"`},
+		{"site", &HtmlOptions{ExternalCss: true, FlowingLayout: true}, `"flowinglayout":true,"output":"directory"`},
+	} {
+		options_json, err := test.options.marshal(test.filename)
+		if err != nil || !strings.Contains(options_json, test.output) {
+			t.Errorf("marshal(%s) = %s, %v", test.filename, options_json, err)
+		}
+	}
+	if _, err := (&HtmlOptions{ExternalImages: true}).marshal("page.htm"); err == nil {
+		t.Errorf("marshal(): expected error for external images in a single file")
+	}
+
+	pdf, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer pdf.Close()
+	_ = pdf.PageAdd()
+	_ = pdf.PageAdd()
+	_ = pdf.PageAddText(1, "Web portal")
+	dir := t.TempDir()
+
+	if err := pdf.SaveHtml(fmt.Sprintf("%s/page.html", dir), nil); err != nil {
+		t.Fatalf("SaveHtml(): %v", err)
+	}
+	html, _ := os.ReadFile(fmt.Sprintf("%s/page.html", dir))
+	if !strings.Contains(string(html), "Web portal") {
+		t.Errorf("SaveHtml(): HTML does not contain the text")
+	}
+
+	if err := pdf.SaveHtml(fmt.Sprintf("%s/site.zip", dir), &HtmlOptions{SplitPages: true, ExternalImages: true, ExternalCss: true}); err != nil {
+		t.Fatalf("SaveHtml(zip): %v", err)
+	}
+	archive, err := zip.OpenReader(fmt.Sprintf("%s/site.zip", dir))
+	if err != nil {
+		t.Fatalf("SaveHtml(zip): %v", err)
+	}
+	defer archive.Close()
+	if _, err := archive.Open("index.html"); err != nil {
+		t.Errorf("SaveHtml(zip): %v", err)
+	}
+
+	if err := pdf.SaveHtml(fmt.Sprintf("%s/site", dir), &HtmlOptions{FlowingLayout: true, ExternalFonts: true}); err != nil {
+		t.Fatalf("SaveHtml(directory): %v", err)
+	}
+	if _, err := os.Stat(fmt.Sprintf("%s/site/index.html", dir)); err != nil {
+		t.Errorf("SaveHtml(directory): %v", err)
+	}
+}
+
+func TestTables(t *testing.T) {
+	table := Table{Page: 1, Rows: []TableRow{
+		{Cells: []TableCell{{Column: 0, ColumnSpan: 2, Text: "Statement"}, {Column: 2, RowSpan: 2, Text: "Total"}}},
+		{Cells: []TableCell{{Column: 0, Text: "Date"}, {Column: 1, Text: "Amount, EUR"}}},
+		{Cells: []TableCell{{Column: 0, Text: "2026-10-01"}, {Column: 1, Text: "1\"000.00"}, {Column: 2, Text: "1000.00"}}},
+	}}
+	assert_eq(t, table.Columns(), 3)
+	assert_eq(t, table.Strings(), [][]string{
+		{"Statement", "", "Total"},
+		{"Date", "Amount, EUR", ""},
+		{"2026-10-01", "1\"000.00", "1000.00"},
+	})
+	var csv bytes.Buffer
+	if err := table.WriteCSV(&csv); err != nil {
+		t.Fatalf("WriteCSV(): %v", err)
+	}
+	assert_eq(t, csv.String(), "Statement,,Total\nDate,\"Amount, EUR\",\n2026-10-01,\"1\"\"000.00\",1000.00\n")
+
+	pdf, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer pdf.Close()
+	_ = pdf.PageAdd()
+	_ = pdf.PageAddText(1, "No tables here")
+	tables, err := pdf.PageTables(1)
+	if err != nil {
+		t.Fatalf("PageTables(): %v", err)
+	}
+	assert_eq(t, len(tables), 0)
+	if _, err := pdf.PageTables(2); err == nil {
+		t.Errorf("PageTables(): expected error for a page out of range")
+	}
+}
+
+func TestStructureTree(t *testing.T) {
+	tree := &StructureElement{Type: "Document", Children: []StructureElement{
+		{Type: "H1", Text: "Report"},
+		{Type: "P", Text: "Summary"},
+		{Type: "L", Children: []StructureElement{
+			{Type: "LI", Children: []StructureElement{{Type: "LBody", Text: "First"}}},
+		}},
+		{Type: "Figure", ID: "12", AltText: "Chart"},
+	}}
+	assert_eq(t, len(tree.Headings()), 1)
+	assert_eq(t, tree.Elements("Figure")[0].AltText, "Chart")
+	assert_eq(t, len(tree.Elements()), 7)
+	var order []string
+	for _, element := range tree.ReadingOrder() {
+		order = append(order, element.Type)
+	}
+	assert_eq(t, order, []string{"H1", "P", "LBody", "Figure"})
+	for language, valid := range map[string]bool{"en": true, "en-US": true, "zh-Hant-TW": true, "es-419": true, "": false, "english": false, "en_US": false, "1a": false} {
+		assert_eq(t, validLanguage(language), valid)
+	}
+
+	pdf, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer pdf.Close()
+	_ = pdf.PageAdd()
+	_ = pdf.PageAddText(1, "Accessible document")
+	tagged, err := pdf.IsTagged()
+	assert_eq(t, err, nil)
+	if !tagged {
+		if _, err := pdf.StructureTree(); !errors.Is(err, ErrNotTagged) {
+			t.Errorf("StructureTree(): %v, want ErrNotTagged", err)
+		}
+	}
+	if err := pdf.AutoTag(); err != nil {
+		t.Fatalf("AutoTag(): %v", err)
+	}
+	tagged, err = pdf.IsTagged()
+	assert_eq(t, err, nil)
+	assert_eq(t, tagged, true)
+	root, err := pdf.StructureTree()
+	if err != nil {
+		t.Fatalf("StructureTree(): %v", err)
+	}
+	text := ""
+	for _, element := range root.ReadingOrder() {
+		text += element.Text
+	}
+	if !strings.Contains(text, "Accessible document") {
+		t.Errorf("StructureTree(): reading order text %q", text)
+	}
+
+	if err := pdf.SetLanguage("en-US"); err != nil {
+		t.Errorf("SetLanguage(): %v", err)
+	}
+	if err := pdf.SetLanguage("English"); err == nil {
+		t.Errorf("SetLanguage(): expected error for an invalid tag")
+	}
+	if err := pdf.SetTitle("Accessible document"); err != nil {
+		t.Errorf("SetTitle(): %v", err)
+	}
+	if err := pdf.SetAltText("missing", "Chart"); err == nil {
+		t.Errorf("SetAltText(): expected error for a missing element")
+	}
+}
+
+func TestEInvoice(t *testing.T) {
+	for guideline, profile := range map[string]EInvoiceProfile{
+		"urn:factur-x.eu:1p0:minimum": EInvoiceMinimum,
+		"urn:cen.eu:en16931:2017":     EInvoiceEN16931,
+		"urn:cen.eu:en16931:2017#compliant#urn:xeinkauf.de:kosit:xrechnung_3.0": EInvoiceXRechnung,
+	} {
+		detected, err := detectEInvoiceProfile(testInvoiceXML(guideline))
+		assert_eq(t, err, nil)
+		assert_eq(t, detected, profile)
+	}
+	if _, err := detectEInvoiceProfile([]byte("<Invoice/>")); err == nil {
+		t.Errorf("detectEInvoiceProfile(): expected error for a non-CII root")
+	}
+
+	doc, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	_ = doc.PageAdd()
+	_ = doc.PageAddText(1, "Invoice INV-2026-001")
+	if _, _, err := doc.ExtractEInvoice(); !errors.Is(err, ErrNoEInvoice) {
+		t.Errorf("ExtractEInvoice(): %v, want ErrNoEInvoice", err)
+	}
+	invoice := testInvoiceXML("urn:cen.eu:en16931:2017")
+	if err := doc.CreateEInvoice(invoice, EInvoiceExtended); err == nil {
+		t.Errorf("CreateEInvoice(): expected error for a profile not matching the XML")
+	}
+	if err := doc.CreateEInvoice(invoice, EInvoiceAuto); err != nil {
+		t.Fatalf("CreateEInvoice(): %v", err)
+	}
+	if err := doc.CreateEInvoice(invoice, EInvoiceAuto); err == nil {
+		t.Errorf("CreateEInvoice(): expected error for a second e-invoice")
+	}
+	filename := fmt.Sprintf("%s/einvoice.pdf", t.TempDir())
+	if err := doc.SaveAs(filename); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+	doc.Close()
+
+	pdf, err := Open(filename)
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+	defer pdf.Close()
+	extracted, profile, err := pdf.ExtractEInvoice()
+	if err != nil {
+		t.Fatalf("ExtractEInvoice(): %v", err)
+	}
+	assert_eq(t, extracted, invoice)
+	assert_eq(t, profile, EInvoiceEN16931)
+
+	attachments, _ := pdf.Attachments()
+	assert_eq(t, len(attachments), 1)
+	assert_eq(t, attachments[0].Name, "factur-x.xml")
+	assert_eq(t, attachments[0].MimeType, "text/xml")
+	assert_eq(t, attachments[0].Relationship, AFAlternative)
+	compliant, err := pdf.IsPdfaCompliant()
+	assert_eq(t, err, nil)
+	assert_eq(t, compliant, true)
+}
+
+func TestAttachments(t *testing.T) {
+	doc, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	_ = doc.PageAdd()
+	csv := []byte("id,amount\n1,42.00\n")
+	if err := doc.AddAttachment(bytes.NewReader(csv), &AttachmentOptions{Name: "data.csv", Description: "Source data", Relationship: AFData}); err != nil {
+		t.Fatalf("AddAttachment(): %v", err)
+	}
+	notes := []byte("Reviewed")
+	rect := Rectangle{X: 500, Y: 750, Width: 20, Height: 20}
+	if err := doc.PageAddFileAttachment(1, rect, bytes.NewReader(notes), &AttachmentOptions{Name: "notes.txt", Icon: "Paperclip"}); err != nil {
+		t.Fatalf("PageAddFileAttachment(): %v", err)
+	}
+	if err := doc.AddAttachment(bytes.NewReader(csv), &AttachmentOptions{}); err == nil {
+		t.Errorf("AddAttachment(): expected error for an empty name")
+	}
+	if err := doc.PageAddFileAttachment(1, rect, bytes.NewReader(notes), &AttachmentOptions{Name: "a.txt", Icon: "Star"}); err == nil {
+		t.Errorf("PageAddFileAttachment(): expected error for an unknown icon")
+	}
+	filename := fmt.Sprintf("%s/attachments.pdf", t.TempDir())
+	if err := doc.SaveAs(filename); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+	doc.Close()
+
+	pdf, err := Open(filename)
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+	defer pdf.Close()
+	attachments, err := pdf.Attachments()
+	if err != nil {
+		t.Fatalf("Attachments(): %v", err)
+	}
+	assert_eq(t, len(attachments), 2)
+	byName := map[string]AttachmentInfo{}
+	for _, attachment := range attachments {
+		byName[attachment.Name] = attachment
+	}
+
+	data := byName["data.csv"]
+	assert_eq(t, data.Description, "Source data")
+	assert_eq(t, data.MimeType, "text/csv")
+	assert_eq(t, data.Size, int64(len(csv)))
+	assert_eq(t, data.Checksum, fmt.Sprintf("%x", md5.Sum(csv)))
+	assert_eq(t, data.Relationship, AFData)
+	assert_eq(t, data.Page, int32(0))
+	if data.CreationDate.IsZero() {
+		t.Errorf("Attachments(): no creation date")
+	}
+	assert_eq(t, byName["notes.txt"].Page, int32(1))
+	assert_eq(t, byName["notes.txt"].MimeType, "text/plain")
+
+	var extracted bytes.Buffer
+	if err := pdf.ExtractAttachment("data.csv", &extracted); err != nil {
+		t.Fatalf("ExtractAttachment(): %v", err)
+	}
+	assert_eq(t, extracted.Bytes(), csv)
+	if err := pdf.ExtractAttachment("missing.csv", io.Discard); err == nil {
+		t.Errorf("ExtractAttachment(): expected error for a missing attachment")
+	}
+
+	if err := pdf.RemoveAttachments(); err != nil {
+		t.Fatalf("RemoveAttachments(): %v", err)
+	}
+	attachments, _ = pdf.Attachments()
+	for _, attachment := range attachments {
+		assert_ne(t, attachment.Page, int32(0))
+	}
+}
+
+func TestAddWatermarkWithOptions(t *testing.T) {
+	// Small PNG image
+	img := image.NewRGBA(image.Rect(0, 0, 20, 10))
+	for x := 0; x < 20; x++ {
+		img.Set(x, 5, color.RGBA{200, 0, 0, 255})
+	}
+	var imgBytes bytes.Buffer
+	_ = png.Encode(&imgBytes, img)
+
+	low, transparent, invalid := 0.2, 0.0, 1.5
+	tests := []struct {
+		name    string
+		options *WatermarkOptions
+		valid   bool
+	}{
+		{"TiledText", &WatermarkOptions{Text: "CONFIDENTIAL - John Doe", Rotation: 45, Tiled: true, TileSpacingX: 50, TileSpacingY: 50, Opacity: &low}, true},
+		{"CornerImage", &WatermarkOptions{Image: imgBytes.Bytes(), Position: PositionBottomRight, ImageScale: 2, PageRange: "2-"}, true},
+		{"Background", &WatermarkOptions{Text: "DRAFT", Position: PositionTopLeft, Background: true}, true},
+		{"Transparent", &WatermarkOptions{Text: "DRAFT", Opacity: &transparent}, true},
+		{"Nil", nil, false},
+		{"Empty", &WatermarkOptions{}, false},
+		{"TextAndImage", &WatermarkOptions{Text: "DRAFT", Image: imgBytes.Bytes()}, false},
+		{"Opacity", &WatermarkOptions{Text: "DRAFT", Opacity: &invalid}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, _ := New()
+			defer doc.Close()
+			_ = doc.PageAdd()
+			_ = doc.PageAdd()
+
+			err := doc.AddWatermarkWithOptions(tt.options)
+			if !tt.valid {
+				if err == nil {
+					t.Errorf("AddWatermarkWithOptions() must fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AddWatermarkWithOptions(): %v", err)
+			}
+
+			// Watermark artifacts are found by RemoveWatermarks
+			if err := doc.RemoveWatermarks(); err != nil {
+				t.Fatalf("RemoveWatermarks(): %v", err)
+			}
+			text, _ := doc.ExtractText()
+			if tt.options.Text != "" && strings.Contains(text, tt.options.Text) {
+				t.Errorf("RemoveWatermarks() did not remove %q", tt.options.Text)
+			}
+		})
+	}
+}
+
+func TestPageLabels(t *testing.T) {
+	// Label formatting
+	ranges := []PageLabelRange{
+		{StartPage: 1, Style: NumberingRomanLower},
+		{StartPage: 5, Style: NumberingDecimal},
+		{StartPage: 8, Style: NumberingLettersUpper, Prefix: "A-", Start: 26},
+	}
+	var labels []string
+	for num := int32(1); num <= 10; num++ {
+		labels = append(labels, pageLabel(ranges, num))
+	}
+	assert_eq(t, labels, []string{"i", "ii", "iii", "iv", "1", "2", "3", "A-Z", "A-AA", "A-BB"})
+	assert_eq(t, romanNumeral(1994), "MCMXCIV")
+	assert_eq(t, pageLabel(nil, 3), "3")
+	assert_eq(t, pageLabel([]PageLabelRange{{StartPage: 1, Style: NumberingNone, Prefix: "Cover"}}, 1), "Cover")
+
+	// Invalid ranges
+	if err := validatePageLabels([]PageLabelRange{{StartPage: 2}}); err == nil {
+		t.Errorf("validatePageLabels() must fail if first range does not start at page 1")
+	}
+	if err := validatePageLabels([]PageLabelRange{{StartPage: 1}, {StartPage: 1}}); err == nil {
+		t.Errorf("validatePageLabels() must fail for unordered ranges")
+	}
+
+	// Parts matching no label are page numbers
+	index := map[string]int32{"i": 1, "ii": 2, "1": 3, "2": 4}
+	for pagerange, want := range map[string]string{"ii-5": "2-5", "1,6-": "3,6-", "-i": "-1", "7": "", "x-2": ""} {
+		resolved, err := resolvePageLabels(index, 6, pagerange)
+		if want == "" {
+			if err == nil {
+				t.Errorf("resolvePageLabels(%q) = %q, want error", pagerange, resolved)
+			}
+		} else if resolved != want || err != nil {
+			t.Errorf("resolvePageLabels(%q) = %q, %v, want %q", pagerange, resolved, err, want)
+		}
+	}
+
+	// Setting and resolving labels
+	doc, _ := New()
+	defer doc.Close()
+	for i := 0; i < 10; i++ {
+		_ = doc.PageAdd()
+	}
+	if err := doc.SetPageLabels(ranges); err != nil {
+		t.Fatalf("SetPageLabels(): %v", err)
+	}
+	stored, err := doc.PageLabels()
+	if err != nil {
+		t.Fatalf("PageLabels(): %v", err)
+	}
+	assert_eq(t, stored, ranges)
+
+	label, _ := doc.PageLabel(3)
+	assert_eq(t, label, "iii")
+	num, err := doc.PageByLabel("2")
+	if err != nil {
+		t.Fatalf("PageByLabel(): %v", err)
+	}
+	assert_eq(t, num, int32(6))
+	if _, err := doc.PageByLabel("xx"); err == nil {
+		t.Errorf("PageByLabel() must fail for unknown label")
+	}
+
+	// Labels containing '-' are matched as a whole
+	pagerange, err := doc.ResolvePageLabels("i-iv,1, A-Z-A-AA,A-BB-")
+	if err != nil {
+		t.Fatalf("ResolvePageLabels(): %v", err)
+	}
+	assert_eq(t, pagerange, "1-4,5,8-9,10-")
+	if _, err := doc.ResolvePageLabels("i-xx"); err == nil {
+		t.Errorf("ResolvePageLabels() must fail for unknown label")
+	}
+}
+
+func TestAddPageNumbers(t *testing.T) {
+	// NumberingNone is only valid for page labels
+	none, _ := New()
+	defer none.Close()
+	if err := none.AddPageNumbers(&PageNumberOptions{Style: NumberingNone}); err == nil {
+		t.Errorf("AddPageNumbers() must fail for NumberingNone")
+	}
+
+	tests := []struct {
+		name     string
+		options  *PageNumberOptions
+		expected []string
+		missing  []string
+	}{
+		{"Default", nil, []string{"1", "3"}, nil},
+		{"Format", &PageNumberOptions{Format: "Page {page} of {pages}", Position: PositionTopRight}, []string{"Page 1 of 3", "Page 3 of 3"}, nil},
+		{"Roman", &PageNumberOptions{Format: "- {page} -", Style: NumberingRomanLower}, []string{"- i -", "- iii -"}, nil},
+		{"StartNumber", &PageNumberOptions{Format: "#{page}", StartNumber: 10}, []string{"#10", "#12"}, []string{"#13"}},
+		{"ExcludeCover", &PageNumberOptions{Format: "#{page}", ExcludePages: "1"}, []string{"#1", "#2"}, []string{"#3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, _ := New()
+			defer doc.Close()
+			for i := 0; i < 3; i++ {
+				_ = doc.PageAdd()
+			}
+			if err := doc.AddPageNumbers(tt.options); err != nil {
+				t.Fatalf("AddPageNumbers(): %v", err)
+			}
+			text, _ := doc.ExtractText()
+			for _, expected := range tt.expected {
+				if !strings.Contains(text, expected) {
+					t.Errorf("AddPageNumbers(): %q not found in %q", expected, text)
+				}
+			}
+			for _, missing := range tt.missing {
+				if strings.Contains(text, missing) {
+					t.Errorf("AddPageNumbers(): %q must not be stamped", missing)
+				}
+			}
+		})
+	}
+}
+
+func TestHeaderFooter(t *testing.T) {
+	newDoc := func(pages int) *Document {
+		doc, err := New()
+		if err != nil {
+			t.Fatalf("New(): %v", err)
+		}
+		for i := 0; i < pages; i++ {
+			_ = doc.PageAdd()
+		}
+		return doc
+	}
+
+	// {date} is resolved with DateFormat
+	now := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	template_json, err := (&HeaderFooter{Left: "{date}", Right: "{page}", DateFormat: "02.01.2006"}).marshal(now)
+	if err != nil {
+		t.Fatalf("marshal(): %v", err)
+	}
+	if !strings.Contains(template_json, `"left":"15.03.2024"`) || !strings.Contains(template_json, `"right":"{page}"`) {
+		t.Errorf("marshal(): unexpected template %s", template_json)
+	}
+
+	// Even pages use the shared slots, mirrored, or their own slots
+	template_json, _ = (&HeaderFooter{Left: "{title}", Right: "{page}", MirrorEvenPages: true}).marshal(now)
+	if !strings.Contains(template_json, `"evenleft":"{page}","evencenter":"","evenright":"{title}"`) || !strings.Contains(template_json, `"mirrorevenpages":false`) {
+		t.Errorf("marshal(): unexpected mirrored template %s", template_json)
+	}
+	template_json, _ = (&HeaderFooter{Left: "{title}", Right: "{page}", EvenCenter: "{date}", DateFormat: "2006"}).marshal(now)
+	if !strings.Contains(template_json, `"left":"{title}","center":"","right":"{page}","evenleft":"","evencenter":"2024","evenright":""`) {
+		t.Errorf("marshal(): unexpected even template %s", template_json)
+	}
+
+	empty := newDoc(1)
+	defer empty.Close()
+	if _, err := empty.AddHeader(nil); err == nil {
+		t.Errorf("AddHeader() must fail for nil template")
+	}
+
+	// Bates numbering continues across files
+	bates := &BatesNumbering{Prefix: "ACME", Start: 1, Digits: 6}
+	template := &HeaderFooter{Right: "{bates}", Bates: bates}
+
+	first := newDoc(3)
+	defer first.Close()
+	stamped, err := first.AddHeader(template)
+	if err != nil {
+		t.Fatalf("AddHeader(): %v", err)
+	}
+	assert_eq(t, stamped, int32(3))
+	assert_eq(t, bates.Start, int64(1))
+	bates.Start += int64(stamped)
+	text, _ := first.ExtractText()
+	if !strings.Contains(text, "ACME000001") || !strings.Contains(text, "ACME000003") {
+		t.Errorf("AddHeader(): Bates numbers not found in %q", text)
+	}
+
+	second := newDoc(2)
+	defer second.Close()
+	stamped, err = second.AddHeader(template)
+	if err != nil {
+		t.Fatalf("AddHeader(): %v", err)
+	}
+	assert_eq(t, stamped, int32(2))
+	assert_eq(t, bates.Start, int64(4))
+	text, _ = second.ExtractText()
+	if !strings.Contains(text, "ACME000004") || strings.Contains(text, "ACME000001") {
+		t.Errorf("AddHeader(): Bates numbering does not continue in %q", text)
+	}
+
+	// Different texts on odd and even pages
+	parity := newDoc(2)
+	defer parity.Close()
+	if _, err := parity.AddHeader(&HeaderFooter{Left: "Odd {page}", EvenRight: "Even {page}"}); err != nil {
+		t.Fatalf("AddHeader(): %v", err)
+	}
+	text, _ = parity.ExtractText()
+	if !strings.Contains(text, "Odd 1") || !strings.Contains(text, "Even 2") || strings.Contains(text, "Odd 2") {
+		t.Errorf("AddHeader(): unexpected odd and even headers in %q", text)
+	}
+
+	// Footer with page fields, first page skipped
+	footer := newDoc(3)
+	defer footer.Close()
+	stamped, err = footer.AddFooter(&HeaderFooter{Center: "Page {page} of {pages}", SkipFirstPage: true})
+	if err != nil {
+		t.Fatalf("AddFooter(): %v", err)
+	}
+	assert_eq(t, stamped, int32(2))
+	text, _ = footer.ExtractText()
+	if strings.Contains(text, "Page 1 of 3") || !strings.Contains(text, "Page 2 of 3") {
+		t.Errorf("AddFooter(): unexpected footer in %q", text)
+	}
+}
+
+func TestFontSources(t *testing.T) {
+	t.Cleanup(func() { _ = ResetFontSources() })
+
+	if err := AddFontDirectory(t.TempDir()); err != nil {
+		t.Fatalf("AddFontDirectory(): %v", err)
+	}
+	if err := AddFontDirectory(fmt.Sprintf("%s/missing", t.TempDir())); err == nil {
+		t.Errorf("AddFontDirectory() must fail for missing directory")
+	}
+	if err := AddFontData(nil); err == nil {
+		t.Errorf("AddFontData() must fail for empty data")
+	}
+	if err := AddFontData([]byte("not a font")); err == nil {
+		t.Errorf("AddFontData() must fail for invalid font")
+	}
+	if err := SetFontSubstitution("CorporateSans"); err == nil {
+		t.Errorf("SetFontSubstitution() must fail without fallbacks")
+	}
+
+	// Missing font is replaced by the first available fallback
+	if err := SetFontSubstitution("CorporateSans", "NoSuchFont", "Arial"); err != nil {
+		t.Fatalf("SetFontSubstitution(): %v", err)
+	}
+	doc, _ := New()
+	defer doc.Close()
+	_ = doc.PageAdd()
+	if err := doc.AddWatermark("Confidential", "CorporateSans", 16, "#010101", 100, 100, 45, true, 0.5); err != nil {
+		t.Fatalf("AddWatermark(): %v", err)
+	}
+	fonts, _ := doc.Fonts()
+	for _, font := range fonts {
+		if font.Name == "CorporateSans" || font.Name == "NoSuchFont" {
+			t.Errorf("Fonts(): %s was not substituted", font.Name)
+		}
+	}
+}
+
+func TestFonts(t *testing.T) {
+	doc, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer doc.Close()
+
+	// Empty document has no fonts
+	fonts, err := doc.Fonts()
+	if err != nil {
+		t.Fatalf("Fonts(): %v", err)
+	}
+	assert_eq(t, len(fonts), 0)
+
+	// Add two pages, text only on the second one
+	_ = doc.PageAdd()
+	_ = doc.PageAdd()
+	if err := doc.PageAddText(2, "Font inventory"); err != nil {
+		t.Fatalf("PageAddText(): %v", err)
+	}
+
+	fonts, err = doc.Fonts()
+	if err != nil {
+		t.Fatalf("Fonts(): %v", err)
+	}
+	if len(fonts) == 0 {
+		t.Fatalf("Fonts(): no fonts found")
+	}
+	for _, font := range fonts {
+		if font.Name == "" {
+			t.Errorf("Fonts(): font without name")
+		}
+		if font.Type == FontUnknown {
+			t.Errorf("Fonts(): %s has unknown type", font.Name)
+		}
+		assert_eq(t, font.Pages, []int32{2})
+		if font.Subset && !font.Embedded {
+			t.Errorf("Fonts(): %s is a subset but not embedded", font.Name)
+		}
+	}
+
+	// All fonts are embedded after EmbedFonts
+	if err := doc.EmbedFonts(); err != nil {
+		t.Fatalf("EmbedFonts(): %v", err)
+	}
+	fonts, _ = doc.Fonts()
+	for _, font := range fonts {
+		if !font.Embedded {
+			t.Errorf("Fonts(): %s is not embedded after EmbedFonts()", font.Name)
+		}
+	}
+}
+
+func TestEncryptionInfo(t *testing.T) {
+	filename := fmt.Sprintf("%s/cryptoinfo.pdf", t.TempDir())
+
+	userPass := "user123"
+	ownerPass := "owner123"
+
+	tests := []struct {
+		algo      CryptoAlgorithm
+		usePdf20  bool
+		keyLength int32
+	}{
+		{RC4x40, false, 40},
+		{RC4x128, false, 128},
+		{AESx128, false, 128},
+		{AESx128, true, 128},
+		{AESx256, false, 256},
+		{AESx256, true, 256},
+	}
+
+	base, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer base.Close()
+
+	// Not encrypted document
+	info, err := base.EncryptionInfo()
+	if err != nil {
+		t.Fatalf("EncryptionInfo(): %v", err)
+	}
+	assert_eq(t, info.Encrypted, false)
+	assert_eq(t, info.PasswordType, PasswordNone)
+
+	if err := base.SaveAs(filename); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+
+	for _, tt := range tests {
+		pdf, err := Open(filename)
+		if err != nil {
+			t.Fatalf("Open(): %v", err)
+		}
+		err = pdf.Encrypt(userPass, ownerPass, PrintDocument, tt.algo, tt.usePdf20)
+		if err != nil {
+			t.Fatalf("Encrypt(%v,usePdf20=%v): %v", tt.algo, tt.usePdf20, err)
+		}
+		cryptfilename := fmt.Sprintf("%s/cryptoinfo_%v_%t.pdf", t.TempDir(), tt.algo, tt.usePdf20)
+		if err := pdf.SaveAs(cryptfilename); err != nil {
+			t.Fatalf("SaveAs(%v,usePdf20=%v): %v", tt.algo, tt.usePdf20, err)
+		}
+		pdf.Close()
+
+		// Opened with the user password
+		pdfUser, err := OpenWithPassword(cryptfilename, userPass)
+		if err != nil {
+			t.Fatalf("OpenWithPassword(%v,usePdf20=%v): %v", tt.algo, tt.usePdf20, err)
+		}
+		info, err := pdfUser.EncryptionInfo()
+		if err != nil {
+			t.Fatalf("EncryptionInfo(%v,usePdf20=%v): %v", tt.algo, tt.usePdf20, err)
+		}
+		assert_eq(t, info.Encrypted, true)
+		assert_eq(t, info.SecurityHandler, "Standard")
+		assert_eq(t, info.Algorithm, tt.algo)
+		assert_eq(t, info.KeyLength, tt.keyLength)
+		assert_eq(t, info.PasswordType, PasswordUser)
+		pdfUser.Close()
+
+		// Opened with the owner password
+		pdfOwner, err := OpenWithPassword(cryptfilename, ownerPass)
+		if err != nil {
+			t.Fatalf("OpenWithPassword(%v,usePdf20=%v): %v", tt.algo, tt.usePdf20, err)
+		}
+		info, _ = pdfOwner.EncryptionInfo()
+		assert_eq(t, info.PasswordType, PasswordOwner)
+		pdfOwner.Close()
+	}
+}
+
+func TestEncryptForRecipients(t *testing.T) {
+	filename := fmt.Sprintf("%s/pubsec.pdf", t.TempDir())
+
+	newRecipient := func(name string) (*x509.Certificate, *rsa.PrivateKey) {
+		key, _ := rsa.GenerateKey(rand.Reader, 2048)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(time.Now().UnixNano()),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageKeyEncipherment,
+		}
+		der, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		cert, _ := x509.ParseCertificate(der)
+		return cert, key
+	}
+	aliceCert, aliceKey := newRecipient("Alice")
+	bobCert, bobKey := newRecipient("Bob")
+	_, eveKey := newRecipient("Eve")
+
+	pdf, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	defer pdf.Close()
+
+	// No recipients must fail
+	if err := pdf.EncryptForRecipients(nil, AESx256); err == nil {
+		t.Fatalf("EncryptForRecipients() must fail without recipients")
+	}
+
+	err = pdf.EncryptForRecipients([]Recipient{
+		{Certificate: aliceCert, Permissions: PrintDocument | ModifyContent},
+		{Certificate: bobCert, Permissions: PrintDocument},
+	}, AESx256)
+	if err != nil {
+		t.Fatalf("EncryptForRecipients(): %v", err)
+	}
+	if err := pdf.SaveAs(filename); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+
+	// Open without certificate must fail
+	if _, err := Open(filename); err == nil {
+		t.Fatalf("Open() without certificate must fail")
+	}
+
+	// Each recipient gets own permissions
+	for _, tt := range []struct {
+		cert  *x509.Certificate
+		key   *rsa.PrivateKey
+		perms Permissions
+	}{
+		{aliceCert, aliceKey, PrintDocument | ModifyContent},
+		{bobCert, bobKey, PrintDocument},
+	} {
+		pdfRecipient, err := OpenWithCertificate(filename, tt.cert, tt.key)
+		if err != nil {
+			t.Fatalf("OpenWithCertificate(%s): %v", tt.cert.Subject, err)
+		}
+		perms, _ := pdfRecipient.GetPermissions()
+		assert_eq(t, perms, tt.perms)
+		pdfRecipient.Close()
+	}
+
+	// Key not matching any recipient must fail
+	if _, err := OpenWithCertificate(filename, aliceCert, eveKey); err == nil {
+		t.Fatalf("OpenWithCertificate() must fail with wrong key")
+	}
+
+	// Certificate without DER encoding must fail
+	if _, err := OpenWithCertificate(filename, &x509.Certificate{}, aliceKey); err == nil {
+		t.Fatalf("OpenWithCertificate() must fail for empty certificate")
+	}
+}
+
+func TestSignField(t *testing.T) {
+	certBytes, password := test_certificate(t)
+	tmpDir := t.TempDir()
+
+	// Empty signature fields signed later
+	pdf, _ := New()
+	defer pdf.Close()
+	_ = pdf.PageAdd()
+	preparedPath := fmt.Sprintf("%s/fields.pdf", tmpDir)
+
+	err := pdf.AddSignatureField(1, Rectangle{X: 50, Y: 50, Width: 200, Height: 70}, "Seller", &SignatureFieldOptions{Lock: LockAll})
+	if err != nil {
+		t.Fatalf("AddSignatureField(): %v", err)
+	}
+	err = pdf.AddSignatureField(1, Rectangle{X: 300, Y: 50, Width: 200, Height: 70}, "Buyer", nil)
+	if err != nil {
+		t.Fatalf("AddSignatureField(): %v", err)
+	}
+	if err := pdf.AddSignatureField(1, Rectangle{}, "", nil); err == nil {
+		t.Errorf("AddSignatureField() must fail for empty name")
+	}
+	if err := pdf.SaveAs(preparedPath); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+
+	pdfFields, _ := Open(preparedPath)
+	defer pdfFields.Close()
+	fields, err := pdfFields.UnsignedSignatureFields()
+	if err != nil {
+		t.Fatalf("UnsignedSignatureFields(): %v", err)
+	}
+	assert_eq(t, len(fields), 2)
+	if len(fields) == 2 {
+		assert_eq(t, fields[0].Name, "Seller")
+		assert_eq(t, fields[0].Lock, LockAll)
+		assert_eq(t, fields[1].Rect, Rectangle{X: 300, Y: 50, Width: 200, Height: 70})
+	}
+
+	signedPath := fmt.Sprintf("%s/fields_signed.pdf", tmpDir)
+	if err := pdfFields.SignField("Seller", certBytes, password, &SignOptions{Reason: "Seller"}, signedPath); err != nil {
+		t.Fatalf("SignField(): %v", err)
+	}
+	if err := pdfFields.SignField("Unknown", certBytes, password, nil, signedPath); err == nil {
+		t.Errorf("SignField() must fail for unknown field")
+	}
+
+	pdfSigned, _ := Open(signedPath)
+	defer pdfSigned.Close()
+	fields, _ = pdfSigned.UnsignedSignatureFields()
+	assert_eq(t, len(fields), 1)
+	if len(fields) == 1 {
+		assert_eq(t, fields[0].Name, "Buyer")
+	}
+
+	// Signing the remaining field in place
+	if err := pdfSigned.Sign(certBytes, password, &SignOptions{FieldName: "Buyer", Reason: "Buyer"}); err != nil {
+		t.Fatalf("Sign(): %v", err)
+	}
+	pdfInPlace, _ := Open(signedPath)
+	defer pdfInPlace.Close()
+	fields, _ = pdfInPlace.UnsignedSignatureFields()
+	assert_eq(t, len(fields), 0)
+}
+
+func TestSignAs(t *testing.T) {
+	certBytes, password := test_certificate(t)
+	tmpDir := t.TempDir()
+
+	// Certifying signature with SignOptions
+	pdf, _ := New()
+	defer pdf.Close()
+	_ = pdf.PageAdd()
+	_ = pdf.PageAdd()
+	outputPath := fmt.Sprintf("%s/sign_options.pdf", tmpDir)
+
+	options := &SignOptions{
+		FieldName:      "Approval",
+		Pages:          []int32{1, 2},
+		X:              100,
+		Y:              100,
+		Width:          200,
+		Height:         100,
+		Reason:         "Certified",
+		Visible:        true,
+		AppearanceText: "Signed by {signer}\n{date}",
+		Hash:           crypto.SHA384,
+		Certification:  CertifiedFormFilling,
+	}
+	err := pdf.SignAs(certBytes, password, options, outputPath)
+	if err != nil {
+		t.Fatalf("SignAs(): %v", err)
+	}
+
+	pdfSign, _ := Open(outputPath)
+	defer pdfSign.Close()
+	signatures, err := pdfSign.Signatures()
+	if err != nil {
+		t.Fatalf("Signatures(): %v", err)
+	}
+	assert_eq(t, len(signatures), 1)
+	if len(signatures) == 1 {
+		assert_eq(t, signatures[0].Name, "Approval")
+		assert_eq(t, signatures[0].Certification, CertifiedFormFilling)
+	}
+
+	// Signing to io.Writer
+	pdfWriter, _ := New()
+	defer pdfWriter.Close()
+	_ = pdfWriter.PageAdd()
+	var buf bytes.Buffer
+	if err := pdfWriter.SignTo(certBytes, password, &SignOptions{Reason: "Writer"}, &buf); err != nil {
+		t.Fatalf("SignTo(): %v", err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+		t.Errorf("SignTo(): output is not a PDF-document")
+	}
+
+	// Unsupported hash algorithm
+	if err := pdfWriter.SignAs(certBytes, password, &SignOptions{Hash: crypto.SHA1}, outputPath); err == nil {
+		t.Errorf("SignAs() must fail for unsupported hash")
+	}
+
+	// Signing in place the file PDF-document was opened from
+	inPlacePath := fmt.Sprintf("%s/sign_in_place.pdf", tmpDir)
+	if err := pdfWriter.SaveAs(inPlacePath); err != nil {
+		t.Fatalf("SaveAs(): %v", err)
+	}
+	pdfInPlace, _ := Open(inPlacePath)
+	if err := pdfInPlace.Sign(certBytes, password, &SignOptions{Reason: "In place"}); err != nil {
+		t.Fatalf("Sign(): %v", err)
+	}
+	pdfInPlace.Close()
+	pdfSigned, _ := Open(inPlacePath)
+	defer pdfSigned.Close()
+	isSig, _ := pdfSigned.IsSigned()
+	if !isSig {
+		t.Errorf("Sign(): IsSigned() is false")
+	}
+}
+
+func TestTimestampClient(t *testing.T) {
+	tsa := newTestTSA(t)
+	digest := sha256.Sum256([]byte("content"))
+
+	token, err := tsa.Timestamp(digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatalf("Timestamp(): %v", err)
+	}
+	info, err := parseTimestampToken(token)
+	if err != nil {
+		t.Fatalf("parseTimestampToken(): %v", err)
+	}
+	assert_eq(t, info.MessageImprint.HashedMessage, digest[:])
+	if info.GenTime.IsZero() {
+		t.Errorf("GenTime is zero")
+	}
+
+	// Unsupported hash
+	if _, err := tsa.Timestamp(digest[:], crypto.MD5); err == nil {
+		t.Errorf("Timestamp() must fail for unsupported hash")
+	}
+
+	// TSA signature, certificate and message imprint
+	chain, err := verifyTimestampToken(token, digest[:], crypto.SHA256, tsa.Roots)
+	if err != nil {
+		t.Fatalf("verifyTimestampToken(): %v", err)
+	}
+	assert_eq(t, len(chain), 1)
+	if _, err := verifyTimestampToken(token, digest[:], crypto.SHA256, x509.NewCertPool()); err == nil {
+		t.Errorf("verifyTimestampToken() must fail for untrusted TSA")
+	}
+	other := sha256.Sum256([]byte("other content"))
+	if _, err := verifyTimestampToken(token, other[:], crypto.SHA256, tsa.Roots); err == nil {
+		t.Errorf("verifyTimestampToken() must fail for another digest")
+	}
+	if _, err := verifyTimestampToken(token, digest[:], crypto.SHA512, tsa.Roots); err == nil {
+		t.Errorf("verifyTimestampToken() must fail for another hash algorithm")
+	}
+	tampered := bytes.Replace(token, digest[:], other[:], 1)
+	if _, err := verifyTimestampToken(tampered, other[:], crypto.SHA256, tsa.Roots); err == nil {
+		t.Errorf("verifyTimestampToken() must fail for tampered TSTInfo")
+	}
+	untrusted := &HTTPTimestampClient{URL: tsa.URL, Roots: x509.NewCertPool()}
+	if _, err := untrusted.Timestamp(digest[:], crypto.SHA256); err == nil {
+		t.Errorf("Timestamp() must fail for untrusted TSA")
+	}
+	tsaChain, err := timestampChain(token)
+	if err != nil {
+		t.Fatalf("timestampChain(): %v", err)
+	}
+	assert_eq(t, tsaChain[0].Subject.CommonName, "Test TSA")
+}
+
+func TestRevocationClient(t *testing.T) {
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	caDer, _ := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	ca, _ := x509.ParseCertificate(caDer)
+	crl, _ := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(time.Hour),
+	}, ca, caKey)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ca.crl" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(crl)
+	}))
+	defer server.Close()
+
+	signerKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	signerTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "Signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		OCSPServer:            []string{server.URL + "/ocsp"},
+		CRLDistributionPoints: []string{server.URL + "/ca.crl"},
+	}
+	signerDer, _ := x509.CreateCertificate(rand.Reader, signerTemplate, ca, &signerKey.PublicKey, caKey)
+	signer, _ := x509.ParseCertificate(signerDer)
+
+	// The failing OCSP responder falls back to the CRL
+	crls, ocspResponses, err := (&HTTPRevocationClient{}).Revocation(signer, ca)
+	if err != nil {
+		t.Fatalf("Revocation(): %v", err)
+	}
+	assert_eq(t, crls, [][]byte{crl})
+	assert_eq(t, len(ocspResponses), 0)
+
+	// CRL not signed by the issuer
+	if _, _, err := (&HTTPRevocationClient{}).Revocation(signer, signer); err == nil {
+		t.Errorf("Revocation() must fail for another issuer")
+	}
+	// No revocation sources
+	if _, _, err := (&HTTPRevocationClient{}).Revocation(ca, ca); err == nil {
+		t.Errorf("Revocation() must fail without OCSP responder and CRL distribution point")
+	}
+}
+
+func TestSignPAdES(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "PAdES Signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	cert, _ := x509.ParseCertificate(der)
+	chain := []*x509.Certificate{cert}
+	tsa := newTestTSA(t)
+
+	tmpDir := t.TempDir()
+
+	tests := []struct {
+		name       string
+		profile    PAdESProfile
+		signatures int
+		revisions  int
+	}{
+		{"B-B", PAdES_B_B, 1, 1},
+		{"B-T", PAdES_B_T, 1, 1},
+		{"B-LT", PAdES_B_LT, 1, 2},
+		{"B-LTA", PAdES_B_LTA, 2, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pdf, _ := New()
+			defer pdf.Close()
+			_ = pdf.PageAdd()
+			outputPath := fmt.Sprintf("%s/pades_%s.pdf", tmpDir, tt.name)
+
+			err := pdf.SignPAdES(key, chain, &SignOptions{Reason: "PAdES"}, &PAdESOptions{Profile: tt.profile, TSA: tsa}, outputPath)
+			if err != nil {
+				t.Fatalf("SignPAdES(): %v", err)
+			}
+
+			pdfSign, err := Open(outputPath)
+			if err != nil {
+				t.Fatalf("Open(%s): %v", outputPath, err)
+			}
+			defer pdfSign.Close()
+			signatures, err := pdfSign.Signatures()
+			if err != nil {
+				t.Fatalf("Signatures(): %v", err)
+			}
+			assert_eq(t, len(signatures), tt.signatures)
+			if len(signatures) > 0 {
+				assert_eq(t, signatures[0].SubFilter, subFilterCAdESDetached)
+			}
+			if len(signatures) > 1 {
+				assert_eq(t, signatures[1].SubFilter, subFilterRFC3161)
+			}
+			revisions, err := pdfSign.Revisions()
+			if err != nil {
+				t.Fatalf("Revisions(): %v", err)
+			}
+			assert_eq(t, len(revisions), tt.revisions)
+		})
+	}
+
+	// TSA is required from B-T
+	pdf, _ := New()
+	defer pdf.Close()
+	err := pdf.SignPAdES(key, chain, nil, &PAdESOptions{Profile: PAdES_B_T}, fmt.Sprintf("%s/no_tsa.pdf", tmpDir))
+	if err == nil {
+		t.Errorf("SignPAdES() must fail without TSA")
+	}
+}
+
+// newTestTSA starts a local RFC 3161 time stamping authority standing in for a real TSA
+// and returns a client trusting its certificate.
+func newTestTSA(t *testing.T) *HTTPTimestampClient {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(100),
+		Subject:               pkix.Name{CommonName: "Test TSA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+		BasicConstraintsValid: true,
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	cert, _ := x509.ParseCertificate(der)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var request tsRequest
+		if _, err := asn1.Unmarshal(body, &request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		info, _ := asn1.Marshal(tstInfo{
+			Version:        1,
+			Policy:         asn1.ObjectIdentifier{1, 2, 3, 4},
+			MessageImprint: request.MessageImprint,
+			SerialNumber:   big.NewInt(time.Now().UnixNano()),
+			GenTime:        time.Now().UTC().Truncate(time.Second),
+			Nonce:          request.Nonce,
+		})
+		digest := sha256.Sum256(info)
+		token, err := (&cmsSigner{
+			signer:      key,
+			chain:       []*x509.Certificate{cert},
+			hash:        crypto.SHA256,
+			contentType: oidTSTInfo,
+			content:     info,
+			signingTime: time.Now(),
+		}).sign(digest[:])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		response, _ := asn1.Marshal(tsResponse{TimeStampToken: asn1.RawValue{FullBytes: token}})
+		w.Header().Set("Content-Type", "application/timestamp-reply")
+		w.Write(response)
+	}))
+	t.Cleanup(server.Close)
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	return &HTTPTimestampClient{URL: server.URL, Roots: roots}
+}
+
+// testInvoiceXML returns a Cross Industry Invoice with the guideline ID.
+func testInvoiceXML(guideline string) []byte {
+	return []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<rsm:CrossIndustryInvoice xmlns:rsm="urn:un:unece:uncefact:data:standard:CrossIndustryInvoice:100" xmlns:ram="urn:un:unece:uncefact:data:standard:ReusableAggregateBusinessInformationEntity:100">
+  <rsm:ExchangedDocumentContext>
+    <ram:GuidelineSpecifiedDocumentContextParameter>
+      <ram:ID>` + guideline + `</ram:ID>
+    </ram:GuidelineSpecifiedDocumentContextParameter>
+  </rsm:ExchangedDocumentContext>
+  <rsm:ExchangedDocument>
+    <ram:ID>INV-2026-001</ram:ID>
+    <ram:TypeCode>380</ram:TypeCode>
+  </rsm:ExchangedDocument>
+</rsm:CrossIndustryInvoice>
+`)
+}
+
+func TestSignWithSigner(t *testing.T) {
+	// Software key standing in for an HSM
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "HSM Signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	cert, _ := x509.ParseCertificate(der)
+	chain := []*x509.Certificate{cert}
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+
+	tmpDir := t.TempDir()
+
+	verify := func(t *testing.T, filename string) {
+		pdfSign, err := Open(filename)
+		if err != nil {
+			t.Fatalf("Open(%s): %v", filename, err)
+		}
+		defer pdfSign.Close()
+		isSig, _ := pdfSign.IsSigned()
+		if !isSig {
+			t.Fatalf("IsSigned() is false")
+		}
+		signatures, err := pdfSign.Signatures()
+		if err != nil {
+			t.Fatalf("Signatures(): %v", err)
+		}
+		assert_eq(t, len(signatures), 1)
+		if len(signatures) == 1 {
+			assert_eq(t, signatures[0].Reason, "HSM")
+			if err := signatures[0].Verify(&VerifyOptions{Roots: roots}); err != nil {
+				t.Errorf("Verify(): %v", err)
+			}
+		}
+	}
+
+	// Sub-test 1: Signing with crypto.Signer
+	t.Run("SignWithSigner", func(t *testing.T) {
+		pdf, _ := New()
+		defer pdf.Close()
+		_ = pdf.PageAdd()
+		outputPath := fmt.Sprintf("%s/signer.pdf", tmpDir)
+
+		err := pdf.SignWithSigner(key, chain, &SignOptions{Reason: "HSM"}, outputPath)
+		if err != nil {
+			t.Fatalf("SignWithSigner(): %v", err)
+		}
+		verify(t, outputPath)
+	})
+
+	// Sub-test 2: Two-phase signing with an externally produced CMS
+	t.Run("PrepareSignature", func(t *testing.T) {
+		pdf, _ := New()
+		defer pdf.Close()
+		_ = pdf.PageAdd()
+		outputPath := fmt.Sprintf("%s/external.pdf", tmpDir)
+
+		prepared, err := pdf.PrepareSignature(&SignOptions{Reason: "HSM"}, outputPath)
+		if err != nil {
+			t.Fatalf("PrepareSignature(): %v", err)
+		}
+
+		// The external party only sees the digest
+		cms, err := (&cmsSigner{signer: key, chain: chain, hash: prepared.Hash, signingTime: time.Now()}).sign(prepared.Digest)
+		if err != nil {
+			t.Fatalf("sign(): %v", err)
+		}
+		if err := prepared.Inject(cms); err != nil {
+			t.Fatalf("Inject(): %v", err)
+		}
+		verify(t, outputPath)
+
+		// Too large signature must not be injected
+		if err := prepared.Inject(make([]byte, defaultSignatureReservedSize+1)); err == nil {
+			t.Errorf("Inject() must fail for oversized signature")
+		}
+	})
+}
+
+func TestCMSEd25519(t *testing.T) {
+	_, key, _ := ed25519.GenerateKey(rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Ed25519 Signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	cert, _ := x509.ParseCertificate(der)
+
+	// RFC 8419 requires SHA-512 with signed attributes
+	options := (&SignOptions{Hash: crypto.SHA256}).forSigner(key)
+	assert_eq(t, options.Hash, crypto.SHA512)
+	if _, err := (&cmsSigner{signer: key, chain: []*x509.Certificate{cert}, hash: crypto.SHA256}).sign(make([]byte, 32)); err == nil {
+		t.Errorf("sign() must fail for Ed25519 with SHA-256")
+	}
+
+	digest := sha512.Sum512([]byte("content"))
+	cms, err := (&cmsSigner{signer: key, chain: []*x509.Certificate{cert}, hash: options.Hash}).sign(digest[:])
+	if err != nil {
+		t.Fatalf("sign(): %v", err)
+	}
+	var contentInfo cmsContentInfo
+	if _, err := asn1.Unmarshal(cms, &contentInfo); err != nil {
+		t.Fatalf("Unmarshal(ContentInfo): %v", err)
+	}
+	var signedData cmsSignedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		t.Fatalf("Unmarshal(SignedData): %v", err)
+	}
+	sha512OID := cmsDigestAlgorithms[crypto.SHA512]
+	assert_eq(t, len(signedData.DigestAlgorithms), 1)
+	assert_eq(t, signedData.DigestAlgorithms[0].Algorithm.Equal(sha512OID), true)
+	assert_eq(t, len(signedData.SignerInfos), 1)
+	if len(signedData.SignerInfos) == 1 {
+		assert_eq(t, signedData.SignerInfos[0].DigestAlgorithm.Algorithm.Equal(sha512OID), true)
+		assert_eq(t, signedData.SignerInfos[0].SignatureAlgorithm.Algorithm.Equal(oidEd25519), true)
+	}
+}
+
+func TestSignatures(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	der, _ := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	cert, _ := x509.ParseCertificate(der)
+
+	pdf, _ := New()
+	defer pdf.Close()
+	_ = pdf.PageAdd()
+	outputPath := fmt.Sprintf("%s/signatures.pdf", t.TempDir())
+	options := &SignOptions{FieldName: "Signature1", Reason: "Approved", Location: "Location Info"}
+	if err := pdf.SignWithSigner(key, []*x509.Certificate{cert}, options, outputPath); err != nil {
+		t.Fatalf("SignWithSigner(): %v", err)
+	}
+
+	// Inspect and verify the signature
+	pdfSign, _ := Open(outputPath)
+	defer pdfSign.Close()
+	signatures, err := pdfSign.Signatures()
+	if err != nil {
+		t.Fatalf("Signatures(): %v", err)
+	}
+	assert_eq(t, len(signatures), 1)
+	if len(signatures) != 1 {
+		return
+	}
+	assert_eq(t, signatures[0].Name, "Signature1")
+	assert_eq(t, signatures[0].Reason, "Approved")
+	assert_eq(t, signatures[0].Location, "Location Info")
+	assert_eq(t, signatures[0].CoversWholeDocument, true)
+	assert_eq(t, len(signatures[0].Certificates), 1)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	if err := signatures[0].Verify(&VerifyOptions{Roots: roots}); err != nil {
+		t.Errorf("Verify(): %v", err)
+	}
+	if err := signatures[0].Verify(&VerifyOptions{Roots: x509.NewCertPool()}); err == nil {
+		t.Errorf("Verify() must fail for untrusted root")
+	}
+}
+
+func TestSignatureRevocation(t *testing.T) {
+	// Create a CA and a signer certificate issued by it
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	caDer, _ := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	ca, _ := x509.ParseCertificate(caDer)
+
+	signerKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	signerTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "Signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	signerDer, _ := x509.CreateCertificate(rand.Reader, signerTemplate, ca, &signerKey.PublicKey, caKey)
+	signer, _ := x509.ParseCertificate(signerDer)
+	chain := []*x509.Certificate{signer, ca}
+
+	// CRL revoking the signer certificate
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(time.Hour),
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{SerialNumber: signer.SerialNumber, RevocationTime: time.Now().Add(-time.Minute)},
+		},
+	}, ca, caKey)
+	if err != nil {
+		t.Fatalf("CreateRevocationList(): %v", err)
+	}
+
+	// Revoked before the check time
+	err = checkRevocation(chain, &VerifyOptions{CRLs: [][]byte{crl}}, time.Now())
+	if !errors.Is(err, ErrCertificateRevoked) {
+		t.Errorf("checkRevocation() must report revocation, got %v", err)
+	}
+
+	// Revoked after the check time (signed before revocation)
+	err = checkRevocation(chain, &VerifyOptions{CRLs: [][]byte{crl}}, time.Now().Add(-30*time.Minute))
+	if err != nil {
+		t.Errorf("checkRevocation(): %v", err)
+	}
+
+	// No revocation data
+	err = checkRevocation(chain, &VerifyOptions{RequireRevocationCheck: true}, time.Now())
+	if !errors.Is(err, ErrRevocationUnknown) {
+		t.Errorf("checkRevocation() must report missing revocation data, got %v", err)
+	}
+
+	// Expired CRL not revoking the signer certificate
+	expired, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(2),
+		ThisUpdate: time.Now().Add(-2 * time.Hour),
+		NextUpdate: time.Now().Add(-time.Hour),
+	}, ca, caKey)
+	if err != nil {
+		t.Fatalf("CreateRevocationList(): %v", err)
+	}
+	err = checkRevocation(chain, &VerifyOptions{CRLs: [][]byte{expired}, RequireRevocationCheck: true}, time.Now())
+	if !errors.Is(err, ErrRevocationUnknown) {
+		t.Errorf("checkRevocation() must ignore expired CRL, got %v", err)
+	}
+	err = checkRevocation(chain, &VerifyOptions{CRLs: [][]byte{expired}, RequireRevocationCheck: true}, time.Now().Add(-90*time.Minute))
+	if err != nil {
+		t.Errorf("checkRevocation() at CRL time: %v", err)
+	}
+
+	// The signer issued by a cross-signed intermediate: revoked by one root, valid under the other
+	interKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	interTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(3),
+		Subject:               pkix.Name{CommonName: "Test Intermediate"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	interDer, _ := x509.CreateCertificate(rand.Reader, interTemplate, ca, &interKey.PublicKey, caKey)
+	inter, _ := x509.ParseCertificate(interDer)
+
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherTemplate := *caTemplate
+	otherTemplate.SerialNumber = big.NewInt(4)
+	otherTemplate.Subject = pkix.Name{CommonName: "Other CA"}
+	otherDer, _ := x509.CreateCertificate(rand.Reader, &otherTemplate, &otherTemplate, &otherKey.PublicKey, otherKey)
+	other, _ := x509.ParseCertificate(otherDer)
+	crossDer, _ := x509.CreateCertificate(rand.Reader, interTemplate, other, &interKey.PublicKey, otherKey)
+	cross, _ := x509.ParseCertificate(crossDer)
+
+	leafDer, _ := x509.CreateCertificate(rand.Reader, signerTemplate, inter, &signerKey.PublicKey, interKey)
+	leaf, _ := x509.ParseCertificate(leafDer)
+
+	interCrl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(3),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(time.Hour),
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{SerialNumber: inter.SerialNumber, RevocationTime: time.Now().Add(-time.Minute)},
+		},
+	}, ca, caKey)
+	if err != nil {
+		t.Fatalf("CreateRevocationList(): %v", err)
+	}
+	revoked := []*x509.Certificate{leaf, inter, ca}
+	valid := []*x509.Certificate{leaf, cross, other}
+	options := &VerifyOptions{CRLs: [][]byte{interCrl}}
+	if err := checkChains([][]*x509.Certificate{revoked, valid}, options, time.Now()); err != nil {
+		t.Errorf("checkChains() must accept the chain under the other root: %v", err)
+	}
+	if err := checkChains([][]*x509.Certificate{revoked}, options, time.Now()); !errors.Is(err, ErrCertificateRevoked) {
+		t.Errorf("checkChains() must report revocation, got %v", err)
+	}
+}
+
+func TestIncrementalSave(t *testing.T) {
+	tmpDir := t.TempDir()
+	filename := fmt.Sprintf("%s/base.pdf", tmpDir)
+
+	// Create the original revision
+	pdf, err := New()
+	if err != nil {
+		t.Fatalf("New(): %v", err)
+	}
+	_ = pdf.PageAdd()
+	if err := pdf.SaveAs(filename); err != nil {
+		pdf.Close()
+		t.Fatalf("SaveAs(): %v", err)
+	}
+	pdf.Close()
+
+	original, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("ReadFile(): %v", err)
+	}
+
+	// Append a second revision
+	pdf, err = Open(filename)
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+	defer pdf.Close()
+	_ = pdf.PageAddText(1, "Second revision")
+	updated := fmt.Sprintf("%s/updated.pdf", tmpDir)
+	if err := pdf.SaveAsIncremental(updated); err != nil {
+		t.Fatalf("SaveAsIncremental(): %v", err)
+	}
+
+	// The original bytes must be kept as a prefix
+	data, err := os.ReadFile(updated)
+	if err != nil {
+		t.Fatalf("ReadFile(): %v", err)
+	}
+	if !bytes.HasPrefix(data, original) {
+		t.Errorf("SaveAsIncremental() rewrote the original revision")
+	}
+
+	pdfUpdated, err := Open(updated)
+	if err != nil {
+		t.Fatalf("Open(%s): %v", updated, err)
+	}
+	defer pdfUpdated.Close()
+
+	revisions, err := pdfUpdated.Revisions()
+	if err != nil {
+		t.Fatalf("Revisions(): %v", err)
+	}
+	assert_eq(t, len(revisions), 2)
+	if len(revisions) == 2 {
+		assert_eq(t, revisions[0].Size, int64(len(original)))
+	}
+
+	// Extract the original revision
+	extracted := fmt.Sprintf("%s/extracted.pdf", tmpDir)
+	if err := pdfUpdated.ExtractRevision(1, extracted); err != nil {
+		t.Fatalf("ExtractRevision(): %v", err)
+	}
+	data, err = os.ReadFile(extracted)
+	if err != nil {
+		t.Fatalf("ReadFile(): %v", err)
+	}
+	assert_eq(t, len(data), len(original))
+}
+
+func TestMergeFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// Create three PDF-documents with 1, 2 and 3 pages
+	inputs := make([]string, 0, 3)
+	for n := 1; n <= 3; n++ {
+		pdf, err := New()
+		if err != nil {
+			t.Fatalf("New(): %v", err)
+		}
+		for i := 0; i < n; i++ {
+			_ = pdf.PageAdd()
+		}
+		filename := fmt.Sprintf("%s/input_%d.pdf", tmpDir, n)
+		if err := pdf.SaveAs(filename); err != nil {
+			pdf.Close()
+			t.Fatalf("SaveAs(%s): %v", filename, err)
+		}
+		pdf.Close()
+		inputs = append(inputs, filename)
+	}
+
+	// Merge files without loading them all
+	merged := fmt.Sprintf("%s/merged.pdf", tmpDir)
+	if err := MergeFiles(merged, inputs, nil); err != nil {
+		t.Fatalf("MergeFiles(): %v", err)
+	}
+
+	pdf, err := Open(merged)
+	if err != nil {
+		t.Fatalf("Open(%s): %v", merged, err)
+	}
+	defer pdf.Close()
+	count, _ := pdf.PageCount()
+	assert_eq(t, count, int32(6))
+
+	// Merge readers
+	readers := make([]io.Reader, 0, len(inputs))
+	for _, input := range inputs {
+		data, err := os.ReadFile(input)
+		if err != nil {
+			t.Fatalf("ReadFile(%s): %v", input, err)
+		}
+		readers = append(readers, bytes.NewReader(data))
+	}
+	mergedReaders := fmt.Sprintf("%s/merged_readers.pdf", tmpDir)
+	if err := MergeReaders(mergedReaders, readers, nil); err != nil {
+		t.Fatalf("MergeReaders(): %v", err)
+	}
+
+	// The failed input must be reported
+	broken := []string{inputs[0], fmt.Sprintf("%s/missing.pdf", tmpDir), inputs[1]}
+	err = MergeFiles(fmt.Sprintf("%s/merged_broken.pdf", tmpDir), broken, nil)
+	var mergeErr *MergeError
+	if !errors.As(err, &mergeErr) {
+		t.Fatalf("MergeFiles() must return *MergeError, got %v", err)
+	}
+	assert_eq(t, mergeErr.Index, 1)
+	assert_eq(t, mergeErr.Input, broken[1])
+}
@@ -0,0 +1,157 @@
+package asposepdf
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ValidationIssue is a compliance problem reported by ValidateWithReport or ConvertWithReport.
+type ValidationIssue struct {
+	Clause    string `json:"clause"`    // Rule or clause of the standard, e.g. 6.3.4 for PDF/A fonts
+	Severity  string `json:"severity"`  // Error or Warning
+	Category  string `json:"category"`  // Group of the problem in the log, e.g. Fonts; the clause section if not grouped
+	Page      int32  `json:"page"`      // Page number, 0 if the problem is not on a page
+	ObjectRef string `json:"objectref"` // Reference of the PDF object, e.g. "12 0", empty if unknown
+	Message   string `json:"message"`   // Description of the problem
+	Fixable   bool   `json:"fixable"`   // Convert can fix the problem
+	Fixed     bool   `json:"fixed"`     // ConvertWithReport fixed the problem: validation after the conversion passed and no longer reports it
+}
+
+// ValidationReport is the structured result of ValidateWithReport and ConvertWithReport.
+type ValidationReport struct {
+	Format     PdfFormat         `json:"format"`     // PDF format validated or converted to
+	Valid      bool              `json:"valid"`      // The document is compliant, after the conversion for ConvertWithReport
+	Issues     []ValidationIssue `json:"issues"`     // Problems in the order of the log
+	Errors     int               `json:"errors"`     // Number of issues with Error severity
+	Warnings   int               `json:"warnings"`   // Number of issues with Warning severity
+	Fixed      int               `json:"fixed"`      // Number of issues fixed by ConvertWithReport
+	Categories map[string]int    `json:"categories"` // Number of issues by category
+	Log        string            `json:"log"`        // Original log of the native library
+}
+
+// Remaining returns the issues not fixed by ConvertWithReport.
+func (report *ValidationReport) Remaining() []ValidationIssue {
+	var issues []ValidationIssue
+	for _, issue := range report.Issues {
+		if !issue.Fixed {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// newValidationReport returns a report with issues parsed from the XML log of Validate or Convert.
+// Problems are <Problem> elements with Severity, Clause, Page, ObjectID and Convertable attributes.
+func newValidationReport(format PdfFormat, valid bool, log string) (*ValidationReport, error) {
+	report := &ValidationReport{Format: format, Valid: valid, Categories: map[string]int{}, Log: log}
+	if strings.TrimSpace(log) == "" {
+		return report, nil
+	}
+	decoder := xml.NewDecoder(strings.NewReader(log))
+	var parents []string
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return report, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid validation log: %w", err)
+		}
+		switch token := token.(type) {
+		case xml.EndElement:
+			parents = parents[:len(parents)-1]
+		case xml.StartElement:
+			if token.Name.Local != "Problem" {
+				parents = append(parents, token.Name.Local)
+				continue
+			}
+			var problem struct {
+				Attrs   []xml.Attr `xml:",any,attr"`
+				Message string     `xml:",chardata"`
+			}
+			if err := decoder.DecodeElement(&problem, &token); err != nil {
+				return nil, fmt.Errorf("invalid validation log: %w", err)
+			}
+			issue := ValidationIssue{Message: strings.TrimSpace(problem.Message)}
+			for _, attr := range problem.Attrs {
+				switch strings.ToLower(attr.Name.Local) {
+				case "clause":
+					issue.Clause = attr.Value
+				case "severity":
+					issue.Severity = attr.Value
+				case "category":
+					issue.Category = attr.Value
+				case "page":
+					page, _ := strconv.ParseInt(attr.Value, 10, 32)
+					issue.Page = int32(page)
+				case "objectid":
+					issue.ObjectRef = attr.Value
+				case "convertable":
+					issue.Fixable, _ = strconv.ParseBool(attr.Value)
+				}
+			}
+			if issue.Category == "" && len(parents) > 1 {
+				issue.Category = parents[len(parents)-1]
+			}
+			if issue.Category == "" {
+				issue.Category = clauseSection(issue.Clause)
+			}
+			report.add(issue)
+		}
+	}
+}
+
+// add appends issue and updates the counts.
+func (report *ValidationReport) add(issue ValidationIssue) {
+	report.Issues = append(report.Issues, issue)
+	switch {
+	case strings.EqualFold(issue.Severity, "Error"):
+		report.Errors++
+	case strings.EqualFold(issue.Severity, "Warning"):
+		report.Warnings++
+	}
+	report.Categories[issue.Category]++
+}
+
+// markFixed takes Valid from the report of the validation after the conversion and, if the validation passed,
+// marks the issues of a conversion report not found in it.
+// Issues are matched by clause and page, as object references and messages may change with the conversion.
+func (report *ValidationReport) markFixed(remaining *ValidationReport) {
+	report.Valid = remaining.Valid
+	if !remaining.Valid {
+		return
+	}
+	type key struct {
+		clause string
+		page   int32
+	}
+	left := map[key]int{}
+	for _, issue := range remaining.Issues {
+		left[key{issue.Clause, issue.Page}]++
+	}
+	for i := range report.Issues {
+		k := key{report.Issues[i].Clause, report.Issues[i].Page}
+		if left[k] > 0 {
+			left[k]--
+			continue
+		}
+		report.Issues[i].Fixed = true
+		report.Fixed++
+	}
+}
+
+// clauseSection returns the first two levels of a clause, e.g. 6.3 for 6.3.4, or General if clause is empty.
+func clauseSection(clause string) string {
+	if clause == "" {
+		return "General"
+	}
+	parts := strings.SplitN(clause, ".", 3)
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	return strings.Join(parts, ".")
+}
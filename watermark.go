@@ -0,0 +1,63 @@
+//go:build asposepdf_unreleased
+
+package asposepdf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// WatermarkOptions contains settings for AddWatermarkWithOptions.
+//
+// Either Text or Image must be set. The watermark is marked as a Watermark artifact,
+// so RemoveWatermarks and PageRemoveWatermarks remove it.
+type WatermarkOptions struct {
+	Text         string   `json:"text"`         // Text of the watermark
+	Image        []byte   `json:"image"`        // PNG or JPEG image of the watermark
+	FontName     string   `json:"fontname"`     // Font name of Text, Helvetica if empty
+	FontSize     float64  `json:"fontsize"`     // Font size of Text, 48 if zero
+	Color        string   `json:"color"`        // Color of Text as #RRGGBB, gray if empty
+	ImageScale   float64  `json:"imagescale"`   // Scale of Image, 1 if zero
+	Position     Position `json:"position"`     // Alignment on the page, center if PositionAuto
+	X            int32    `json:"x"`            // Horizontal offset from the aligned position
+	Y            int32    `json:"y"`            // Vertical offset from the aligned position
+	Rotation     int32    `json:"rotation"`     // Rotation in degrees counterclockwise, e.g. 45 for diagonal
+	Opacity      *float64 `json:"opacity"`      // Opacity from 0 to 1, 0.5 if nil
+	Background   bool     `json:"background"`   // Place the watermark behind the page content
+	Tiled        bool     `json:"tiled"`        // Repeat the watermark across the whole page
+	TileSpacingX float64  `json:"tilespacingx"` // Horizontal gap between tiles in points
+	TileSpacingY float64  `json:"tilespacingy"` // Vertical gap between tiles in points
+	PageRange    string   `json:"pagerange"`    // Pages to watermark, e.g. "-2,4,6-8,10-"; all pages if empty
+}
+
+// validate checks that options describe exactly one watermark with a valid opacity.
+func (options *WatermarkOptions) validate() error {
+	if (options.Text == "") == (len(options.Image) == 0) {
+		return errors.New("either Text or Image must be set")
+	}
+	if options.Opacity != nil && (*options.Opacity < 0 || *options.Opacity > 1) {
+		return errors.New("opacity must be from 0 to 1")
+	}
+	return nil
+}
+
+// AddWatermarkWithOptions adds a text or image watermark to pages of PDF-document.
+//
+// Example:
+//
+//	opacity := 0.2
+//	err := pdf.AddWatermarkWithOptions(&WatermarkOptions{Text: "CONFIDENTIAL - John Doe", Rotation: 45, Tiled: true, Opacity: &opacity})
+func (document *Document) AddWatermarkWithOptions(options *WatermarkOptions) error {
+	if options == nil {
+		return errors.New("AddWatermarkWithOptions(): options are nil")
+	}
+	if e := options.validate(); e != nil {
+		return fmt.Errorf("AddWatermarkWithOptions(): %w", e)
+	}
+	options_json, e := json.Marshal(options)
+	if e != nil {
+		return e
+	}
+	return document.addWatermarkWithOptions(string(options_json))
+}